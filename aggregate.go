@@ -0,0 +1,99 @@
+package jason
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// SumBy returns the sum of the numbers
+// at the JSON Pointer ptr within each element of arr.
+//
+// The sum is computed exactly, using [big.Rat].
+// By default, elements where ptr is missing or not a number are an error;
+// SkipMissing and SkipInvalid skip them instead.
+// The sum of no numbers is 0.
+//
+// Example of totaling the amounts of a list of orders:
+//
+//	total, err := jason.SumBy(orders, "/amount")
+func SumBy(arr RawArray, ptr string, opts ...FieldOption) (Number, error) {
+	var sum big.Rat
+	err := aggregateBy(arr, ptr, opts, func(_ Number, r *big.Rat) {
+		sum.Add(&sum, r)
+	})
+	if err != nil {
+		return "", err
+	}
+	return ratNumber(&sum)
+}
+
+// MinBy returns the smallest of the numbers
+// at the JSON Pointer ptr within each element of arr,
+// as written in arr.
+//
+// Elements are handled as by SumBy.
+// If there are no numbers, MinBy returns an error.
+func MinBy(arr RawArray, ptr string, opts ...FieldOption) (Number, error) {
+	return extremeBy(arr, ptr, opts, -1)
+}
+
+// MaxBy returns the largest of the numbers
+// at the JSON Pointer ptr within each element of arr,
+// as written in arr.
+//
+// Elements are handled as by SumBy.
+// If there are no numbers, MaxBy returns an error.
+func MaxBy(arr RawArray, ptr string, opts ...FieldOption) (Number, error) {
+	return extremeBy(arr, ptr, opts, +1)
+}
+
+var errNoNumbers = errors.New("jason: no numbers to aggregate")
+
+func extremeBy(arr RawArray, ptr string, opts []FieldOption, sign int) (Number, error) {
+	var res Number
+	var best *big.Rat
+	err := aggregateBy(arr, ptr, opts, func(n Number, r *big.Rat) {
+		if best == nil || r.Cmp(best) == sign {
+			res, best = n, r
+		}
+	})
+	if err != nil {
+		return "", err
+	}
+	if best == nil {
+		return "", errNoNumbers
+	}
+	return res, nil
+}
+
+// aggregateBy calls fn with the number at ptr within each element of arr.
+func aggregateBy(arr RawArray, ptr string, opts []FieldOption, fn func(Number, *big.Rat)) error {
+	if _, err := SplitPointer(ptr); err != nil {
+		return err
+	}
+	o := fieldOptions(opts)
+
+	for i, v := range arr {
+		val, err := Pointer(v, ptr)
+		if err != nil {
+			if isMissing(err) && o&SkipMissing != 0 {
+				continue
+			}
+			return fmt.Errorf("jason: index %d: %w", i, err)
+		}
+		n, ok := sortNumber(val)
+		if !ok {
+			if o&SkipInvalid != 0 {
+				continue
+			}
+			return fmt.Errorf("jason: index %d: %s is not a number", i, KindOf(val))
+		}
+		r, err := ToBigRat(n)
+		if err != nil {
+			return fmt.Errorf("jason: index %d: %w", i, err)
+		}
+		fn(n, r)
+	}
+	return nil
+}
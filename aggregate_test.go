@@ -0,0 +1,69 @@
+package jason
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSumBy(t *testing.T) {
+	tests := []struct {
+		name    string
+		arr     []string
+		opts    []FieldOption
+		want    Number
+		wantErr bool
+	}{
+		{name: "integers", arr: []string{`{"n":1}`, `{"n":2}`, `{"n":-4}`}, want: "-1"},
+		{name: "exact", arr: []string{`{"n":0.1}`, `{"n":0.2}`}, want: "0.3"},
+		{name: "big", arr: []string{`{"n":9007199254740993}`, `{"n":1e20}`}, want: "100009007199254740993"},
+		{name: "empty", arr: nil, want: "0"},
+		{name: "missing", arr: []string{`{"n":1}`, `{}`}, wantErr: true},
+		{name: "skip missing", arr: []string{`{"n":1}`, `{}`, `[]`}, opts: []FieldOption{SkipMissing}, want: "1"},
+		{name: "invalid", arr: []string{`{"n":1}`, `{"n":"2"}`}, opts: []FieldOption{SkipMissing}, wantErr: true},
+		{name: "skip invalid", arr: []string{`{"n":1}`, `{"n":"2"}`, `{"n":null}`}, opts: []FieldOption{SkipInvalid}, want: "1"},
+		{name: "skip both", arr: []string{`{"n":1}`, `{"n":"2"}`, `{}`}, opts: []FieldOption{SkipMissing, SkipInvalid}, want: "1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SumBy(rawArrayOf(tt.arr), "/n", tt.opts...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SumBy() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("SumBy() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+	if _, err := SumBy(nil, "n"); err == nil {
+		t.Error("SumBy() accepted an invalid pointer")
+	}
+}
+
+func TestMinMaxBy(t *testing.T) {
+	arr := rawArrayOf([]string{`{"n":2.50}`, `{"n":-1e1}`, `{"n":"x"}`, `{"n":3}`, `{"n":-10}`, `{"n":3.0}`})
+	opts := []FieldOption{SkipInvalid}
+
+	if got, err := MinBy(arr, "/n", opts...); err != nil || got != "-1e1" {
+		t.Errorf("MinBy() = %s, %v, want -1e1", got, err)
+	}
+	if got, err := MaxBy(arr, "/n", opts...); err != nil || got != "3" {
+		t.Errorf("MaxBy() = %s, %v, want 3", got, err)
+	}
+	if _, err := MaxBy(arr, "/n"); err == nil {
+		t.Error("MaxBy() accepted a string")
+	}
+	if _, err := MinBy(arr, "/m", SkipMissing); !errors.Is(err, errNoNumbers) {
+		t.Errorf("MinBy() error = %v, want %v", err, errNoNumbers)
+	}
+	if _, err := MaxBy(nil, "/n"); !errors.Is(err, errNoNumbers) {
+		t.Errorf("MaxBy() error = %v, want %v", err, errNoNumbers)
+	}
+}
+
+func rawArrayOf(s []string) RawArray {
+	var arr RawArray
+	for _, e := range s {
+		arr = append(arr, RawValue(e))
+	}
+	return arr
+}
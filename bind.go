@@ -0,0 +1,70 @@
+package jason
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+)
+
+// Bind converts the decoded tree, such as an Object or Array,
+// into a value of type T, by marshaling it and unmarshaling the result.
+//
+// Unlike a plain round-trip, a failure to unmarshal is reported as a [*PathError]
+// with the JSON Pointer to the offending value, when it can be located.
+//
+// Example of landing a mutated tree into a struct:
+//
+//	cfg, err := jason.Bind[Config](tree)
+func Bind[T any](tree any) (v T, err error) {
+	j, err := json.Marshal(tree)
+	if err != nil {
+		return v, err
+	}
+	if err := json.Unmarshal(j, &v); err != nil {
+		var ptr string
+		var typ *json.UnmarshalTypeError
+		if errors.As(err, &typ) {
+			ptr = pointerAt(j, typ.Offset)
+		}
+		return v, &PathError{Path: ptr, Op: "bind", Err: err}
+	}
+	return v, nil
+}
+
+// pointerAt returns a JSON Pointer to the value of j
+// whose first token ends at offset, or the empty pointer.
+func pointerAt(j RawValue, offset int64) string {
+	var path []string
+	var index []int // next array index, or -1 for objects
+
+	s := newTokenScanner(j)
+	for {
+		t, key, depth, err := s.next()
+		if err != nil {
+			return ""
+		}
+
+		switch {
+		case key:
+			path[depth-1] = t.(string)
+			continue
+		case t == json.Delim('}') || t == json.Delim(']'):
+			path, index = path[:depth], index[:depth]
+			continue
+		case depth > 0 && index[depth-1] >= 0:
+			path[depth-1] = strconv.Itoa(index[depth-1])
+			index[depth-1]++
+		}
+
+		if s.offset() == offset {
+			return JoinPointer(path...)
+		}
+
+		switch t {
+		case json.Delim('{'):
+			path, index = append(path, ""), append(index, -1)
+		case json.Delim('['):
+			path, index = append(path, ""), append(index, 0)
+		}
+	}
+}
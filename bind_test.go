@@ -0,0 +1,56 @@
+package jason
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestBind(t *testing.T) {
+	type item struct {
+		N int `json:"n"`
+	}
+	type doc struct {
+		Name  string `json:"name"`
+		Items []item `json:"items"`
+	}
+	tests := []struct {
+		name    string
+		tree    any
+		want    doc
+		wantErr bool
+		path    string
+	}{
+		{name: "ok", tree: Object{"name": "a", "items": Array{Object{"n": Number("1")}}},
+			want: doc{Name: "a", Items: []item{{N: 1}}}},
+		{name: "nested", tree: Object{"items": Array{Object{"n": 1}, Object{"n": "x"}}}, wantErr: true, path: "/items/1/n"},
+		{name: "field", tree: Object{"name": 1}, wantErr: true, path: "/name"},
+		{name: "root", tree: Array{}, wantErr: true, path: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Bind[doc](tt.tree)
+			if !tt.wantErr {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if got.Name != tt.want.Name || len(got.Items) != 1 || got.Items[0] != tt.want.Items[0] {
+					t.Errorf("Bind() = %+v, want %+v", got, tt.want)
+				}
+				return
+			}
+			var perr *PathError
+			if !errors.As(err, &perr) {
+				t.Fatalf("Bind() error = %v, want a *PathError", err)
+			}
+			if perr.Path != tt.path || perr.Op != "bind" {
+				t.Errorf("Bind() error path = %q, op = %q, want %q, bind", perr.Path, perr.Op, tt.path)
+			}
+		})
+	}
+}
+
+func TestBind_marshalError(t *testing.T) {
+	if _, err := Bind[any](Object{"f": func() {}}); err == nil {
+		t.Error("Bind() of a func succeeded")
+	}
+}
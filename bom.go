@@ -0,0 +1,77 @@
+package jason
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// FromReaderAny reads and validates a JSON document from r,
+// that may start with a byte order mark (BOM).
+//
+// A UTF-8 BOM is removed, and UTF-16 input, little or big endian,
+// as detected by its BOM, is converted to UTF-8.
+// Input without a BOM is read as UTF-8.
+// The result must be valid UTF-8 and valid JSON.
+//
+// Example of reading a file written by a Windows tool:
+//
+//	f, err := os.Open("export.json")
+//	j, err := jason.FromReaderAny(f)
+func FromReaderAny(r io.Reader) (RawValue, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case bytes.HasPrefix(b, []byte{0xef, 0xbb, 0xbf}):
+		b = b[3:]
+	case bytes.HasPrefix(b, []byte{0xff, 0xfe}):
+		b, err = fromUTF16(b[2:], binary.LittleEndian)
+	case bytes.HasPrefix(b, []byte{0xfe, 0xff}):
+		b, err = fromUTF16(b[2:], binary.BigEndian)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !utf8.Valid(b) {
+		return nil, errors.New("jason: invalid UTF-8")
+	}
+	if err := Validate(b); err != nil {
+		return nil, fmt.Errorf("jason: %w", err)
+	}
+	return b, nil
+}
+
+func fromUTF16(b []byte, order binary.ByteOrder) ([]byte, error) {
+	if len(b)%2 != 0 {
+		return nil, errors.New("jason: invalid UTF-16: odd number of bytes")
+	}
+	units := make([]uint16, len(b)/2)
+	for i := range units {
+		units[i] = order.Uint16(b[2*i:])
+	}
+
+	buf := make([]byte, 0, len(b))
+	for i := 0; i < len(units); i++ {
+		r := rune(units[i])
+		if utf16.IsSurrogate(r) {
+			r = utf8.RuneError
+			if i+1 < len(units) {
+				r = utf16.DecodeRune(rune(units[i]), rune(units[i+1]))
+				i++
+			}
+			if r == utf8.RuneError {
+				return nil, errors.New("jason: invalid UTF-16: unpaired surrogate")
+			}
+		}
+		buf = utf8.AppendRune(buf, r)
+	}
+	return buf, nil
+}
@@ -0,0 +1,53 @@
+package jason
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strings"
+	"testing"
+	"unicode/utf16"
+)
+
+func utf16Of(s string, order binary.AppendByteOrder) []byte {
+	var b []byte
+	for _, u := range utf16.Encode([]rune(s)) {
+		b = order.AppendUint16(b, u)
+	}
+	return b
+}
+
+func TestFromReaderAny(t *testing.T) {
+	const doc = `{"a":"ñ😀"}`
+	tests := []struct {
+		name    string
+		in      []byte
+		wantErr bool
+	}{
+		{name: "utf-8", in: []byte(doc)},
+		{name: "utf-8 bom", in: append([]byte{0xef, 0xbb, 0xbf}, doc...)},
+		{name: "utf-16le", in: append([]byte{0xff, 0xfe}, utf16Of(doc, binary.LittleEndian)...)},
+		{name: "utf-16be", in: append([]byte{0xfe, 0xff}, utf16Of(doc, binary.BigEndian)...)},
+		{name: "odd utf-16", in: append([]byte{0xff, 0xfe}, utf16Of(doc, binary.LittleEndian)[1:]...), wantErr: true},
+		{name: "unpaired", in: []byte{0xfe, 0xff, 0, '"', 0xd8, 0x3d, 0, '"'}, wantErr: true},
+		{name: "reversed", in: []byte{0xfe, 0xff, 0, '"', 0xde, 0, 0xd8, 0x3d, 0, '"'}, wantErr: true},
+		{name: "invalid utf-8", in: []byte("\"\xff\""), wantErr: true},
+		{name: "invalid json", in: []byte(`{"a":`), wantErr: true},
+		{name: "bom only", in: []byte{0xef, 0xbb, 0xbf}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FromReaderAny(bytes.NewReader(tt.in))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FromReaderAny() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && string(got) != doc {
+				t.Errorf("FromReaderAny() = %s, want %s", got, doc)
+			}
+		})
+	}
+
+	_, err := FromReaderAny(bytes.NewReader([]byte{0xfe, 0xff, 0, '1', 0xd8, 0x3d}))
+	if err == nil || !strings.Contains(err.Error(), "unpaired surrogate") {
+		t.Errorf("FromReaderAny() error = %v, want an unpaired surrogate", err)
+	}
+}
@@ -0,0 +1,142 @@
+package jason
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Builder builds a JSON document by setting values at paths.
+//
+// Paths are dot separated, as accepted by Get.
+// Missing intermediate objects and arrays are created as needed,
+// and conflicting values are replaced, as by Unflatten.
+// Array indices can be at most the length of the array,
+// which appends to it; larger indices are an error.
+// The first error is reported by Build;
+// methods called after an error do nothing.
+//
+// Example of building a request body:
+//
+//	body, err := jason.NewBuilder().
+//		Set("user.name", "Alice").
+//		SetRaw("user.meta", meta).
+//		Append("tags", "new", "hot").
+//		Build()
+type Builder struct {
+	root any
+	err  error
+}
+
+// NewBuilder returns a Builder for an empty object.
+func NewBuilder() *Builder {
+	return &Builder{root: Object{}}
+}
+
+// Set sets the value at path to value,
+// which will be marshaled by Build.
+// Objects and arrays in value are copied,
+// so later changes through the Builder do not modify them.
+func (b *Builder) Set(path string, value any) *Builder {
+	if b.err != nil {
+		return b
+	}
+	segs, err := parsePath(path)
+	if err != nil {
+		b.err = err
+		return b
+	}
+	root, err := unflatten(b.root, segs, copyTree(value))
+	if err != nil {
+		b.err = &PathError{Path: path, Op: "set", Err: err}
+		return b
+	}
+	b.root = root
+	return b
+}
+
+// SetRaw sets the value at path to the pre-encoded value j.
+// The value is copied, so later changes to j do not affect the Builder.
+func (b *Builder) SetRaw(path string, j RawValue) *Builder {
+	if b.err == nil {
+		if err := Validate(j); err != nil {
+			b.err = &PathError{Path: path, Op: "set", Err: err}
+			return b
+		}
+	}
+	return b.Set(path, RawValue(bytes.Clone(j)))
+}
+
+// Append appends values to the array at path,
+// creating it if it does not exist.
+func (b *Builder) Append(path string, values ...any) *Builder {
+	if b.err != nil {
+		return b
+	}
+	segs, err := parsePath(path)
+	if err != nil {
+		b.err = err
+		return b
+	}
+
+	node := b.root
+	for _, seg := range segs {
+		if seg.index < 0 {
+			obj, _ := node.(Object)
+			node = obj[seg.key]
+		} else if arr, _ := node.(Array); seg.index < len(arr) {
+			node = arr[seg.index]
+		} else {
+			node = nil
+		}
+	}
+
+	arr, ok := node.(Array)
+	if !ok && node != nil {
+		b.err = &PathError{Path: path, Op: "append", Err: ErrNotArray}
+		return b
+	}
+	for _, v := range values {
+		arr = append(arr, copyTree(v))
+	}
+	root, err := unflatten(b.root, segs, arr)
+	if err != nil {
+		b.err = &PathError{Path: path, Op: "append", Err: err}
+		return b
+	}
+	b.root = root
+	return b
+}
+
+// Build marshals the document built so far.
+func (b *Builder) Build() (RawValue, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	return json.Marshal(b.root)
+}
+
+// copyTree deep copies the objects and arrays in v,
+// and shares any other values.
+func copyTree(v any) any {
+	switch v := v.(type) {
+	case Object:
+		if v == nil {
+			return v
+		}
+		o := make(Object, len(v))
+		for k, e := range v {
+			o[k] = copyTree(e)
+		}
+		return o
+	case Array:
+		if v == nil {
+			return v
+		}
+		a := make(Array, len(v))
+		for i, e := range v {
+			a[i] = copyTree(e)
+		}
+		return a
+	}
+	return v
+}
@@ -0,0 +1,71 @@
+package jason
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestBuilder_copies(t *testing.T) {
+	user := Object{"name": "Alice"}
+	tags := Array{"a"}
+	_, err := NewBuilder().
+		Set("user", user).
+		Set("user.name", "Bob").
+		Set("user.meta.x", 1).
+		Append("list", tags).
+		Set("list[0][0]", "b").
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !equal(user, Object{"name": "Alice"}) || !equal(tags, Array{"a"}) {
+		t.Errorf("the Builder modified its inputs: %v %v", user, tags)
+	}
+}
+
+func TestBuilder_index(t *testing.T) {
+	tests := []struct {
+		name    string
+		build   func(*Builder) *Builder
+		want    string
+		wantErr error
+	}{
+		{"append by index", func(b *Builder) *Builder { return b.Set("a[0]", 1).Set("a[1]", 2) }, `{"a":[1,2]}`, nil},
+		{"replace by index", func(b *Builder) *Builder { return b.Append("a", 1, 2).Set("a[0]", 3) }, `{"a":[3,2]}`, nil},
+		{"skips index", func(b *Builder) *Builder { return b.Set("a[1]", 1) }, "", ErrIndexRange},
+		{"huge index", func(b *Builder) *Builder { return b.Set("a[99999999999]", 1) }, "", ErrIndexRange},
+		{"append skips index", func(b *Builder) *Builder { return b.Append("a[3]", 1) }, "", ErrIndexRange},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.build(NewBuilder()).Build()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Build() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && !Equal(got, RawValue(tt.want)) {
+				t.Errorf("Build() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuilder_setRaw(t *testing.T) {
+	meta := RawValue(`{"x":1}`)
+	b := NewBuilder().SetRaw("meta", meta)
+	copy(meta, `{"y":2}`)
+	got, err := b.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := RawValue(`{"meta":{"x":1}}`); !Equal(got, want) {
+		t.Errorf("Build() = %s, want %s", got, want)
+	}
+}
+
+func TestBuilder_setRawInvalid(t *testing.T) {
+	_, err := NewBuilder().SetRaw("meta", RawValue(`{`)).Build()
+	var perr *PathError
+	if !errors.As(err, &perr) || perr.Path != "meta" || perr.Op != "set" {
+		t.Errorf("Build() error = %v, want a set PathError for meta", err)
+	}
+}
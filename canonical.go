@@ -0,0 +1,174 @@
+package jason
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// Canonical marshals v into its canonical form (RFC 8785).
+//
+// Object keys are sorted by their UTF-16 code units,
+// insignificant whitespace is removed,
+// numbers are serialized like ECMAScript does,
+// and strings use the minimal escaping required.
+//
+// v can be a RawValue, which is parsed, a decoded value,
+// or any other value that can be marshaled.
+//
+// Example of canonicalizing a document before signing it:
+//
+//	jason.Canonical(jason.RawValue(`{"b": 1.0, "a": "x"}`))
+func Canonical(v any) (RawValue, error) {
+	return appendCanonical(nil, v)
+}
+
+func appendCanonical(buf []byte, v any) ([]byte, error) {
+	switch v := v.(type) {
+	case nil:
+		return append(buf, "null"...), nil
+	case bool:
+		return strconv.AppendBool(buf, v), nil
+	case string:
+		if !utf8.ValidString(v) {
+			return nil, errors.New("jason: invalid UTF-8 in string")
+		}
+		return appendQuoted(buf, v), nil
+	case Number:
+		if !validNumber(v) {
+			return nil, fmt.Errorf("jason: invalid number literal %q", v)
+		}
+		f, err := strconv.ParseFloat(string(v), 64)
+		if err != nil {
+			return nil, fmt.Errorf("jason: number %s out of range", v)
+		}
+		return appendES6(buf, f), nil
+	case float64:
+		if math.IsInf(v, 0) || math.IsNaN(v) {
+			return nil, fmt.Errorf("jason: unsupported value: %v", v)
+		}
+		return appendES6(buf, v), nil
+
+	case Array:
+		buf = append(buf, '[')
+		for i, e := range v {
+			if i > 0 {
+				buf = append(buf, ',')
+			}
+			var err error
+			if buf, err = appendCanonical(buf, e); err != nil {
+				return nil, err
+			}
+		}
+		return append(buf, ']'), nil
+
+	case Object:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			if !utf8.ValidString(k) {
+				return nil, errors.New("jason: invalid UTF-8 in object key")
+			}
+			keys = append(keys, k)
+		}
+		sortUTF16(keys)
+
+		buf = append(buf, '{')
+		for i, k := range keys {
+			if i > 0 {
+				buf = append(buf, ',')
+			}
+			buf = appendQuoted(buf, k)
+			buf = append(buf, ':')
+			var err error
+			if buf, err = appendCanonical(buf, v[k]); err != nil {
+				return nil, err
+			}
+		}
+		return append(buf, '}'), nil
+
+	case RawValue:
+		tree, err := decodeTree(v)
+		if err != nil {
+			return nil, err
+		}
+		return appendCanonical(buf, tree)
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return appendCanonical(buf, RawValue(b))
+}
+
+// appendES6 appends f formatted like ECMAScript's Number.prototype.toString.
+func appendES6(buf []byte, f float64) []byte {
+	if f == 0 {
+		return append(buf, '0') // also -0
+	}
+	format := byte('f')
+	if abs := math.Abs(f); abs < 1e-6 || abs >= 1e21 {
+		format = 'e'
+	}
+	buf = strconv.AppendFloat(buf, f, format, -1, 64)
+	if format == 'e' {
+		// clean up e-09 to e-9
+		n := len(buf)
+		if n >= 4 && buf[n-4] == 'e' && buf[n-3] == '-' && buf[n-2] == '0' {
+			buf[n-2] = buf[n-1]
+			buf = buf[:n-1]
+		}
+	}
+	return buf
+}
+
+// appendQuoted appends s as a JSON string with minimal escaping.
+func appendQuoted(buf []byte, s string) []byte {
+	const hex = "0123456789abcdef"
+	buf = append(buf, '"')
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '"', '\\':
+			buf = append(buf, '\\', c)
+		case '\b':
+			buf = append(buf, '\\', 'b')
+		case '\t':
+			buf = append(buf, '\\', 't')
+		case '\n':
+			buf = append(buf, '\\', 'n')
+		case '\f':
+			buf = append(buf, '\\', 'f')
+		case '\r':
+			buf = append(buf, '\\', 'r')
+		default:
+			if c < 0x20 {
+				buf = append(buf, '\\', 'u', '0', '0', hex[c>>4], hex[c&0xf])
+			} else {
+				buf = append(buf, c)
+			}
+		}
+	}
+	return append(buf, '"')
+}
+
+// sortUTF16 sorts keys by their UTF-16 code units.
+func sortUTF16(keys []string) {
+	units := make(map[string][]uint16, len(keys))
+	for _, k := range keys {
+		units[k] = utf16.Encode([]rune(k))
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		a, b := units[keys[i]], units[keys[j]]
+		for n := 0; n < len(a) && n < len(b); n++ {
+			if a[n] != b[n] {
+				return a[n] < b[n]
+			}
+		}
+		return len(a) < len(b)
+	})
+}
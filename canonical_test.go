@@ -0,0 +1,40 @@
+package jason
+
+import "testing"
+
+func TestCanonical(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      any
+		want    string
+		wantErr bool
+	}{
+		{name: "whitespace", in: RawValue(` { "b" : [ 1 , true , null ] , "a" : "x" } `), want: `{"a":"x","b":[1,true,null]}`},
+		{name: "numbers", in: RawValue(`[1.0, -0, 1e21, 1e-7, 123e-20, 0.000001, 5e-324, 1.7976931348623157e308, 295147905179352830000, 9007199254740993]`),
+			want: `[1,0,1e+21,1e-7,1.23e-18,0.000001,5e-324,1.7976931348623157e+308,295147905179352830000,9007199254740992]`},
+		{name: "strings", in: RawValue(`"\u20ac$\u000F\u000aA'\u0042\u0022\u005c\\\"\/<>&"`), want: `"€$\u000f\nA'B\"\\\\\"/<>&"`},
+		{name: "utf16 order", in: RawValue(`{"\u20ac":1,"\r":2,"\ufb33":3,"1":4,"\ud83d\ude00":5,"\u0080":6,"\u00f6":7}`),
+			want: "{\"\\r\":2,\"1\":4,\"\u0080\":6,\"ö\":7,\"€\":1,\"😀\":5,\"\ufb33\":3}"},
+		{name: "tree", in: Object{"b": Array{Number("10.50"), 2.5}, "a": nil}, want: `{"a":null,"b":[10.5,2.5]}`},
+		{name: "struct", in: struct {
+			B int    `json:"b"`
+			A string `json:"a"`
+		}{1, "x"}, want: `{"a":"x","b":1}`},
+		{name: "invalid", in: RawValue(`{`), wantErr: true},
+		{name: "out of range", in: RawValue(`1e999`), wantErr: true},
+		{name: "invalid number", in: Number("01"), wantErr: true},
+		{name: "invalid utf-8", in: "\xff", wantErr: true},
+		{name: "invalid key", in: Object{"\xff": 1}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Canonical(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Canonical() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Canonical() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package jason
+
+import "fmt"
+
+// Clone deep copies a decoded JSON value, panics on error.
+//
+// The value must be made of Object, Array, Number, RawValue,
+// string, float64, bool and nil values;
+// any other type is an error.
+//
+// Example of creating a copy of a configuration that can be modified:
+//
+//	cfg := jason.Clone(base).(jason.Object)
+func Clone(v any) any {
+	switch v := v.(type) {
+	case Object:
+		if v == nil {
+			return v
+		}
+		o := make(Object, len(v))
+		for k, e := range v {
+			o[k] = Clone(e)
+		}
+		return o
+	case Array:
+		if v == nil {
+			return v
+		}
+		a := make(Array, len(v))
+		for i, e := range v {
+			a[i] = Clone(e)
+		}
+		return a
+	case RawValue:
+		return append(RawValue(nil), v...)
+	case Number, string, float64, bool, nil:
+		return v
+	}
+	panic(fmt.Sprintf("jason: cannot clone value of type %T", v))
+}
@@ -0,0 +1,42 @@
+package jason
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestClone(t *testing.T) {
+	raw := RawValue(`[1]`)
+	orig := Object{
+		"a": Array{Object{"b": "c"}, Number("1"), 1.5, true, nil},
+		"r": raw,
+		"e": Object(nil),
+	}
+	got := Clone(orig).(Object)
+	if !reflect.DeepEqual(got, orig) {
+		t.Fatalf("Clone() = %v, want %v", got, orig)
+	}
+
+	got["a"].(Array)[0].(Object)["b"] = "x"
+	got["a"].(Array)[1] = Number("2")
+	got["r"].(RawValue)[1] = '2'
+	if orig["a"].(Array)[0].(Object)["b"] != "c" || orig["a"].(Array)[1] != Number("1") || string(raw) != `[1]` {
+		t.Errorf("modifying the clone modified the original: %v", orig)
+	}
+	if got["e"].(Object) != nil {
+		t.Errorf("Clone() of a nil Object = %v, want nil", got["e"])
+	}
+}
+
+func TestClone_panics(t *testing.T) {
+	for _, v := range []any{1, []int{}, map[string]int{}, RawObject{}, Array{int8(1)}} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Clone(%#v) did not panic", v)
+				}
+			}()
+			Clone(v)
+		}()
+	}
+}
@@ -0,0 +1,139 @@
+package jason
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Coerce unmarshals j into a value of type T,
+// like AsA, but converting between scalar types when needed.
+//
+// Coercions are attempted only if unmarshaling fails,
+// and depend on the kind of T, and the kind of JSON value:
+//
+//   - into strings: numbers, as written, and booleans, as "true" or "false";
+//   - into booleans: the numbers 0 and 1,
+//     and strings accepted by [strconv.ParseBool];
+//   - into integers: numbers with an integer value, like 5.0 or 5e2,
+//     and strings holding such numbers;
+//   - into floats: strings holding a number.
+//
+// Pointers to these types are coerced as the types they point to.
+// Any other conversion fails.
+//
+// Example of converting j into an int, even if j is the string "123":
+//
+//	if v, err := jason.Coerce[int](j); err == nil { ... }
+func Coerce[T any](j RawValue) (T, error) {
+	var v T
+	if err := json.Unmarshal(j, &v); err == nil {
+		return v, nil
+	}
+
+	typ := reflect.TypeOf(&v).Elem()
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	c, ok := coerce(j, typ.Kind())
+	if !ok {
+		return v, fmt.Errorf("jason: cannot coerce %s into %v", KindOf(j), typ)
+	}
+	v = *new(T)
+	err := json.Unmarshal(c, &v)
+	return v, err
+}
+
+func coerce(j RawValue, kind reflect.Kind) (RawValue, bool) {
+	j = bytes.TrimSpace(j)
+
+	switch KindOf(j) {
+	case BoolKind:
+		if kind == reflect.String {
+			return From(string(j)), true
+		}
+
+	case StringKind:
+		var s string
+		if json.Unmarshal(j, &s) != nil {
+			return nil, false
+		}
+		s = strings.TrimSpace(s)
+		if kind == reflect.Bool {
+			b, err := strconv.ParseBool(s)
+			return From(b), err == nil
+		}
+		if n := Number(s); validNumber(n) {
+			return coerceNumber(n, kind)
+		}
+
+	case NumberKind:
+		n := Number(j)
+		switch kind {
+		case reflect.String:
+			return From(string(n)), true
+		case reflect.Bool:
+			r, err := ToBigRat(n)
+			if err != nil {
+				return nil, false
+			}
+			switch {
+			case r.Sign() == 0:
+				return RawValue("false"), true
+			case r.Cmp(big.NewRat(1, 1)) == 0:
+				return RawValue("true"), true
+			}
+			return nil, false
+		}
+		return coerceNumber(n, kind)
+	}
+
+	return nil, false
+}
+
+func coerceNumber(n Number, kind reflect.Kind) (RawValue, bool) {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		i, err := ToBigInt(n)
+		if err != nil {
+			return nil, false
+		}
+		return RawValue(i.String()), true
+	case reflect.Float32, reflect.Float64:
+		return RawValue(n), true
+	}
+	return nil, false
+}
+
+// AsString returns the natural string form of the scalar j:
+// the unquoted contents of a string, the literal digits of a number,
+// and "true" or "false" for a boolean.
+//
+// Null, objects and arrays are an error;
+// null is reported as ErrNull.
+//
+// Example of building a composite key from mixed-type fields:
+//
+//	id, err := jason.AsString(j) // "42" for 42, and for "42"
+func AsString(j RawValue) (string, error) {
+	j = bytes.TrimSpace(j)
+	switch k := KindOf(j); k {
+	case StringKind:
+		var s string
+		err := json.Unmarshal(j, &s)
+		return s, err
+	case NumberKind, BoolKind:
+		return string(j), nil
+	case NullKind:
+		return "", fmt.Errorf("jason: %w", ErrNull)
+	case InvalidKind:
+		return "", Validate(j)
+	default:
+		return "", fmt.Errorf("jason: cannot convert %s into string", k)
+	}
+}
@@ -0,0 +1,156 @@
+package jason
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCoerce(t *testing.T) {
+	t.Run("string", func(t *testing.T) {
+		tests := []struct {
+			in      string
+			want    string
+			wantErr bool
+		}{
+			{in: `"abc"`, want: "abc"},
+			{in: `123`, want: "123"},
+			{in: `1.50e3`, want: "1.50e3"},
+			{in: `true`, want: "true"},
+			{in: `false`, want: "false"},
+			{in: `[1]`, wantErr: true},
+			{in: `{}`, wantErr: true},
+		}
+		for _, tt := range tests {
+			got, err := Coerce[string](RawValue(tt.in))
+			if (err != nil) != tt.wantErr || got != tt.want {
+				t.Errorf("Coerce[string](%s) = %q, %v, want %q, %v", tt.in, got, err, tt.want, tt.wantErr)
+			}
+		}
+	})
+
+	t.Run("bool", func(t *testing.T) {
+		tests := []struct {
+			in      string
+			want    bool
+			wantErr bool
+		}{
+			{in: `true`, want: true},
+			{in: `0`, want: false},
+			{in: `1`, want: true},
+			{in: `1.0`, want: true},
+			{in: `" true "`, want: true},
+			{in: `"F"`, want: false},
+			{in: `"0"`, want: false},
+			{in: `2`, wantErr: true},
+			{in: `"yes"`, wantErr: true},
+			{in: `[]`, wantErr: true},
+		}
+		for _, tt := range tests {
+			got, err := Coerce[bool](RawValue(tt.in))
+			if (err != nil) != tt.wantErr || got != tt.want {
+				t.Errorf("Coerce[bool](%s) = %v, %v, want %v, %v", tt.in, got, err, tt.want, tt.wantErr)
+			}
+		}
+	})
+
+	t.Run("int", func(t *testing.T) {
+		tests := []struct {
+			in      string
+			want    int
+			wantErr bool
+		}{
+			{in: `5`, want: 5},
+			{in: `5.0`, want: 5},
+			{in: `5e2`, want: 500},
+			{in: `-1E1`, want: -10},
+			{in: `"123"`, want: 123},
+			{in: `" 5e1 "`, want: 50},
+			{in: `5.5`, wantErr: true},
+			{in: `"5.5"`, wantErr: true},
+			{in: `"abc"`, wantErr: true},
+			{in: `true`, wantErr: true},
+		}
+		for _, tt := range tests {
+			got, err := Coerce[int](RawValue(tt.in))
+			if (err != nil) != tt.wantErr || got != tt.want {
+				t.Errorf("Coerce[int](%s) = %v, %v, want %v, %v", tt.in, got, err, tt.want, tt.wantErr)
+			}
+		}
+		if _, err := Coerce[uint8](RawValue(`"300"`)); err == nil {
+			t.Error("Coerce[uint8] accepted an out of range number")
+		}
+	})
+
+	t.Run("float", func(t *testing.T) {
+		tests := []struct {
+			in      string
+			want    float64
+			wantErr bool
+		}{
+			{in: `1.5`, want: 1.5},
+			{in: `"1.5"`, want: 1.5},
+			{in: `"-2e-1"`, want: -0.2},
+			{in: `"NaN"`, wantErr: true},
+			{in: `true`, wantErr: true},
+		}
+		for _, tt := range tests {
+			got, err := Coerce[float64](RawValue(tt.in))
+			if (err != nil) != tt.wantErr || got != tt.want {
+				t.Errorf("Coerce[float64](%s) = %v, %v, want %v, %v", tt.in, got, err, tt.want, tt.wantErr)
+			}
+		}
+	})
+
+	t.Run("pointer", func(t *testing.T) {
+		got, err := Coerce[*int](RawValue(`"42"`))
+		if err != nil || got == nil || *got != 42 {
+			t.Errorf("Coerce[*int] = %v, %v, want 42", got, err)
+		}
+		got, err = Coerce[*int](RawValue(`null`))
+		if err != nil || got != nil {
+			t.Errorf("Coerce[*int](null) = %v, %v, want nil", got, err)
+		}
+	})
+
+	t.Run("other", func(t *testing.T) {
+		type point struct{ X int }
+		if _, err := Coerce[point](RawValue(`"1"`)); err == nil {
+			t.Error("Coerce[struct] accepted a string")
+		}
+		if _, err := Coerce[int](RawValue(`"1`)); err == nil {
+			t.Error("Coerce[int] accepted invalid JSON")
+		}
+	})
+}
+
+func TestAsString(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr error
+	}{
+		{in: `"abc"`, want: "abc"},
+		{in: ` "a\nb" `, want: "a\nb"},
+		{in: `42`, want: "42"},
+		{in: `1.50e3`, want: "1.50e3"},
+		{in: `true`, want: "true"},
+		{in: `false`, want: "false"},
+		{in: `null`, wantErr: ErrNull},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := AsString(RawValue(tt.in))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("AsString() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("AsString() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+	for _, in := range []string{`[1]`, `{}`, ``, `"abc`, `12 3`, `tru`} {
+		if _, err := AsString(RawValue(in)); err == nil {
+			t.Errorf("AsString(%s) succeeded, want an error", in)
+		}
+	}
+}
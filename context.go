@@ -0,0 +1,91 @@
+package jason
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+)
+
+// DecodeContext reads the next JSON value from r and unmarshals it into v,
+// aborting with ctx.Err() if ctx is done before decoding completes.
+//
+// The context is checked between tokens, and reads from r
+// are abandoned as soon as ctx is done, even if they block;
+// in that case, r should no longer be used.
+// Like a [json.Decoder], it may read data from r beyond the value.
+//
+// Example of decoding a response within a deadline:
+//
+//	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+//	defer cancel()
+//	err := jason.DecodeContext(ctx, resp.Body, &v)
+func DecodeContext(ctx context.Context, r io.Reader, v any) error {
+	var buf bytes.Buffer
+	dec := json.NewDecoder(io.TeeReader(&ctxReader{ctx: ctx, r: r}, &buf))
+
+	depth := 0
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		t, err := dec.Token()
+		if err != nil {
+			if depth > 0 {
+				return unexpectedEOF(err)
+			}
+			return err
+		}
+		switch t {
+		case json.Delim('{'), json.Delim('['):
+			depth++
+		case json.Delim('}'), json.Delim(']'):
+			depth--
+		}
+		if depth == 0 {
+			break
+		}
+	}
+	return json.Unmarshal(buf.Bytes()[:dec.InputOffset()], v)
+}
+
+// ctxReader is a reader that abandons reads when ctx is done.
+type ctxReader struct {
+	ctx context.Context
+	r   io.Reader
+	buf []byte
+}
+
+func (c *ctxReader) Read(p []byte) (int, error) {
+	if err := c.ctx.Err(); err != nil {
+		return 0, err
+	}
+	if c.ctx.Done() == nil {
+		return c.r.Read(p)
+	}
+
+	// Read into a private buffer, which is only reused
+	// once the previous read has completed.
+	if cap(c.buf) < len(p) {
+		c.buf = make([]byte, len(p))
+	}
+	buf := c.buf[:len(p)]
+
+	type result struct {
+		n   int
+		err error
+	}
+	ch := make(chan result, 1)
+	go func() {
+		n, err := c.r.Read(buf)
+		ch <- result{n, err}
+	}()
+
+	select {
+	case <-c.ctx.Done():
+		c.buf = nil
+		return 0, c.ctx.Err()
+	case res := <-ch:
+		return copy(p, buf[:res.n]), res.err
+	}
+}
@@ -0,0 +1,58 @@
+package jason
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDecodeContext(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    any
+		wantErr error
+	}{
+		{in: `{"a": [1, 2]}`, want: Object{"a": Array{1.0, 2.0}}},
+		{in: ` "s" `, want: "s"},
+		{in: `[1] [2]`, want: Array{1.0}},
+		{in: `[1,`, wantErr: io.ErrUnexpectedEOF},
+		{in: ``, wantErr: io.EOF},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			var got any
+			err := DecodeContext(context.Background(), strings.NewReader(tt.in), &got)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("DecodeContext() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && !equal(got, tt.want) {
+				t.Errorf("DecodeContext() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodeContext_canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	var v any
+	if err := DecodeContext(ctx, strings.NewReader(`1`), &v); !errors.Is(err, context.Canceled) {
+		t.Errorf("DecodeContext() error = %v, want context.Canceled", err)
+	}
+}
+
+func TestDecodeContext_blocked(t *testing.T) {
+	r, w := io.Pipe()
+	defer w.Close()
+	go w.Write([]byte(`{"a": [1, `))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	var v any
+	if err := DecodeContext(ctx, r, &v); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("DecodeContext() error = %v, want context.DeadlineExceeded", err)
+	}
+}
@@ -0,0 +1,65 @@
+package jason
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Decoder is a reusable decoding policy, used with DecodeWith.
+//
+// The zero value decodes like AsA.
+type Decoder struct {
+	// UseNumber decodes numbers into an any as Number, not float64.
+	UseNumber bool
+	// DisallowUnknownFields rejects object keys
+	// that do not match any struct field, like AsAStrict.
+	DisallowUnknownFields bool
+	// DisallowDuplicateKeys rejects objects with duplicate keys,
+	// like DecodeStrict.
+	DisallowDuplicateKeys bool
+	// MaxBytes, if positive, rejects longer values with ErrTooLarge.
+	MaxBytes int
+	// MaxDepth, if positive, rejects values nested deeper with ErrTooDeep.
+	MaxDepth int
+}
+
+// DecodeWith unmarshals j into a value of type T,
+// following the policy set by d.
+//
+// Limits and duplicate keys are checked before decoding.
+//
+// Example of decoding requests with a shared policy:
+//
+//	var policy = jason.Decoder{DisallowUnknownFields: true, MaxBytes: 1 << 20}
+//	req, err := jason.DecodeWith[Request](policy, body)
+func DecodeWith[T any](d Decoder, j RawValue) (v T, err error) {
+	maxBytes, maxDepth := d.MaxBytes, d.MaxDepth
+	if maxBytes <= 0 {
+		maxBytes = -1
+	}
+	if maxDepth <= 0 {
+		maxDepth = -1
+	}
+	if err := checkLimits(j, maxBytes, maxDepth); err != nil {
+		return v, err
+	}
+	if d.DisallowDuplicateKeys {
+		if err := checkDuplicates(j); err != nil {
+			return v, err
+		}
+	}
+
+	if !d.UseNumber && !d.DisallowUnknownFields {
+		err = json.Unmarshal(j, &v)
+		return v, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(j))
+	if d.UseNumber {
+		dec.UseNumber()
+	}
+	if d.DisallowUnknownFields {
+		dec.DisallowUnknownFields()
+	}
+	err = decodeAll(dec, &v)
+	return v, err
+}
@@ -0,0 +1,58 @@
+package jason
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestDecodeWith(t *testing.T) {
+	type point struct{ X, Y int }
+
+	if got, err := DecodeWith[point](Decoder{}, RawValue(`{"X":1,"Z":2}`)); err != nil || got != (point{X: 1}) {
+		t.Errorf("DecodeWith() = %v, %v, want {1 0}", got, err)
+	}
+	if _, err := DecodeWith[point](Decoder{DisallowUnknownFields: true}, RawValue(`{"X":1,"Z":2}`)); err == nil {
+		t.Error("DecodeWith() accepted an unknown field")
+	}
+	if _, err := DecodeWith[point](Decoder{DisallowUnknownFields: true}, RawValue(`{"X":1} {}`)); err == nil {
+		t.Error("DecodeWith() accepted trailing data")
+	}
+
+	got, err := DecodeWith[any](Decoder{UseNumber: true}, RawValue(`[12345678901234567890]`))
+	if want := (Array{Number("12345678901234567890")}); err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("DecodeWith() = %#v, %v, want %#v", got, err, want)
+	}
+	got, err = DecodeWith[any](Decoder{}, RawValue(`[1]`))
+	if want := (Array{1.0}); err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("DecodeWith() = %#v, %v, want %#v", got, err, want)
+	}
+
+	if _, err := DecodeWith[Object](Decoder{}, RawValue(`{"a":1,"a":2}`)); err != nil {
+		t.Errorf("DecodeWith() error = %v, want duplicates allowed", err)
+	}
+	if _, err := DecodeWith[Object](Decoder{DisallowDuplicateKeys: true}, RawValue(`{"a":1,"a":2}`)); err == nil {
+		t.Error("DecodeWith() accepted a duplicate key")
+	}
+
+	tests := []struct {
+		name string
+		d    Decoder
+		want error
+	}{
+		{"no limits", Decoder{}, nil},
+		{"bytes", Decoder{MaxBytes: 9}, ErrTooLarge},
+		{"enough bytes", Decoder{MaxBytes: 10}, nil},
+		{"depth", Decoder{MaxDepth: 2}, ErrTooDeep},
+		{"enough depth", Decoder{MaxDepth: 3}, nil},
+		{"disabled", Decoder{MaxBytes: -1, MaxDepth: -1}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := DecodeWith[any](tt.d, RawValue(`[[[1]], 2]`))
+			if !errors.Is(err, tt.want) {
+				t.Errorf("DecodeWith() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
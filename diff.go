@@ -0,0 +1,237 @@
+package jason
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Diff computes a JSON Patch (RFC 6902) that transforms a into b.
+//
+// Objects are compared key by key, arrays element by element,
+// and any other difference is a replacement.
+// Applying the patch to a with ApplyPatch yields a value Equal to b.
+//
+// Example of reviewing changes between two versions of a document:
+//
+//	patch, err := jason.Diff(old, new)
+func Diff(a, b RawValue) (RawArray, error) {
+	x, err := decodeTree(a)
+	if err != nil {
+		return nil, err
+	}
+	y, err := decodeTree(b)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := diffTree(nil, nil, x, y)
+	patch := make(RawArray, 0, len(changes))
+	for _, c := range changes {
+		op, err := c.operation()
+		if err != nil {
+			return nil, err
+		}
+		patch = append(patch, op)
+	}
+	return patch, nil
+}
+
+// DiffInvertible is like Diff, but also returns the inverse patch,
+// that transforms b back into a.
+//
+// The inverse undoes the forward patch in reverse order:
+// additions become removals, removals become additions of the removed values,
+// and replacements restore the prior values.
+// Applying inverse to b with ApplyPatch yields a value Equal to a.
+//
+// Example of recording an edit in an undo stack:
+//
+//	redo, undo, err := jason.DiffInvertible(before, after)
+func DiffInvertible(a, b RawValue) (forward, inverse RawArray, err error) {
+	x, err := decodeTree(a)
+	if err != nil {
+		return nil, nil, err
+	}
+	y, err := decodeTree(b)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	changes := diffTree(nil, nil, x, y)
+	forward = make(RawArray, len(changes))
+	inverse = make(RawArray, len(changes))
+	for i, c := range changes {
+		op, err := c.operation()
+		if err != nil {
+			return nil, nil, err
+		}
+		forward[i] = op
+
+		op, err = c.inverse().operation()
+		if err != nil {
+			return nil, nil, err
+		}
+		inverse[len(changes)-1-i] = op
+	}
+	return forward, inverse, nil
+}
+
+// change is a difference between two decoded trees.
+type change struct {
+	op       string // "add", "remove" or "replace"
+	path     string
+	old, new any // old is nil for "add", new is nil for "remove"
+}
+
+func (c change) operation() (RawValue, error) {
+	op := operation{Op: c.op, Path: &c.path}
+	if c.op != "remove" {
+		v, err := json.Marshal(c.new)
+		if err != nil {
+			return nil, err
+		}
+		op.Value = v
+	}
+	return json.Marshal(op)
+}
+
+// inverse returns the change that undoes c.
+func (c change) inverse() change {
+	switch c.op {
+	case "add":
+		c.op = "remove"
+	case "remove":
+		c.op = "add"
+	}
+	c.old, c.new = c.new, c.old
+	return c
+}
+
+// diffTree appends to changes the differences between a and b,
+// both located at path.
+func diffTree(changes []change, path []string, a, b any) []change {
+	if equal(a, b) {
+		return changes
+	}
+
+	switch a := a.(type) {
+	case Object:
+		if b, ok := b.(Object); ok {
+			keys := make([]string, 0, len(a)+len(b))
+			for k := range a {
+				keys = append(keys, k)
+			}
+			for k := range b {
+				if _, ok := a[k]; !ok {
+					keys = append(keys, k)
+				}
+			}
+			sort.Strings(keys)
+
+			for _, k := range keys {
+				p := append(path[:len(path):len(path)], k)
+				x, inA := a[k]
+				y, inB := b[k]
+				switch {
+				case !inB:
+					changes = append(changes, change{op: "remove", path: JoinPointer(p...), old: x})
+				case !inA:
+					changes = append(changes, change{op: "add", path: JoinPointer(p...), new: y})
+				default:
+					changes = diffTree(changes, p, x, y)
+				}
+			}
+			return changes
+		}
+
+	case Array:
+		if b, ok := b.(Array); ok {
+			i := 0
+			for ; i < len(a) && i < len(b); i++ {
+				p := append(path[:len(path):len(path)], strconv.Itoa(i))
+				changes = diffTree(changes, p, a[i], b[i])
+			}
+			for j := i; j < len(b); j++ {
+				p := append(path[:len(path):len(path)], strconv.Itoa(j))
+				changes = append(changes, change{op: "add", path: JoinPointer(p...), new: b[j]})
+			}
+			for j := len(a) - 1; j >= i; j-- {
+				p := append(path[:len(path):len(path)], strconv.Itoa(j))
+				changes = append(changes, change{op: "remove", path: JoinPointer(p...), old: a[j]})
+			}
+			return changes
+		}
+	}
+
+	return append(changes, change{op: "replace", path: JoinPointer(path...), old: a, new: b})
+}
+
+// DiffText summarizes the differences between a and b
+// in a human readable form, one line per change.
+//
+// Added values are prefixed with "+", removed values with "-",
+// and replaced scalars with "~", showing the old and new values.
+// Replacements involving objects or arrays are shown
+// as a removal followed by an addition.
+// Changes are listed in the same order as Diff,
+// and identical documents produce the empty string.
+//
+// Example of the summary for two versions of a document:
+//
+//	- /user/name: "Alice"
+//	+ /user/nick: "Bob"
+//	~ /count: 3 -> 4
+func DiffText(a, b RawValue) (string, error) {
+	x, err := decodeTree(a)
+	if err != nil {
+		return "", err
+	}
+	y, err := decodeTree(b)
+	if err != nil {
+		return "", err
+	}
+
+	var buf strings.Builder
+	line := func(prefix, path string, values ...any) error {
+		buf.WriteString(prefix)
+		if path != "" {
+			buf.WriteByte(' ')
+			buf.WriteString(path)
+			buf.WriteByte(':')
+		}
+		for i, v := range values {
+			if i > 0 {
+				buf.WriteString(" ->")
+			}
+			j, err := json.Marshal(v)
+			if err != nil {
+				return err
+			}
+			buf.WriteByte(' ')
+			buf.Write(j)
+		}
+		buf.WriteByte('\n')
+		return nil
+	}
+
+	for _, c := range diffTree(nil, nil, x, y) {
+		switch {
+		case c.op == "add":
+			err = line("+", c.path, c.new)
+		case c.op == "remove":
+			err = line("-", c.path, c.old)
+		case isContainer(c.old) || isContainer(c.new):
+			if err = line("-", c.path, c.old); err == nil {
+				err = line("+", c.path, c.new)
+			}
+		default:
+			err = line("~", c.path, c.old, c.new)
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+	return buf.String(), nil
+}
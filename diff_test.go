@@ -0,0 +1,113 @@
+package jason
+
+import "testing"
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want string
+	}{
+		{"equal", `{"a":1,"b":[1]}`, `{"b":[1.0],"a":1}`, `[]`},
+		{"add", `{"a":1}`, `{"a":1,"b":2}`, `[{"op":"add","path":"/b","value":2}]`},
+		{"remove", `{"a":1,"b":2}`, `{"a":1}`, `[{"op":"remove","path":"/b"}]`},
+		{"replace", `{"a":1}`, `{"a":"1"}`, `[{"op":"replace","path":"/a","value":"1"}]`},
+		{"escaped", `{}`, `{"a/b~":1}`, `[{"op":"add","path":"/a~1b~0","value":1}]`},
+		{"append", `[1]`, `[1,2,3]`, `[{"op":"add","path":"/1","value":2},{"op":"add","path":"/2","value":3}]`},
+		{"truncate", `[1,2,3]`, `[1]`, `[{"op":"remove","path":"/2"},{"op":"remove","path":"/1"}]`},
+		{"root", `1`, `[1]`, `[{"op":"replace","path":"","value":[1]}]`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := RawValue(tt.a), RawValue(tt.b)
+			patch, err := Diff(a, b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !Equal(From(patch), RawValue(tt.want)) {
+				t.Errorf("Diff() = %s, want %s", From(patch), tt.want)
+			}
+			got, err := ApplyPatch(a, patch)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !Equal(got, b) {
+				t.Errorf("ApplyPatch(Diff()) = %s, want %s", got, b)
+			}
+		})
+	}
+	if _, err := Diff(RawValue(`{`), RawValue(`{}`)); err == nil {
+		t.Error("Diff() of invalid JSON succeeded")
+	}
+}
+
+func TestDiffInvertible(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+	}{
+		{"equal", `{"a":1}`, `{"a":1.0}`},
+		{"add", `{"a":1}`, `{"a":1,"b":{"c":[1]}}`},
+		{"remove", `{"a":1,"b":{"c":null}}`, `{"a":1}`},
+		{"replace", `{"a":1,"b":"x"}`, `{"a":[1],"b":"y"}`},
+		{"nested arrays", `{"a":[[1,2],[3]]}`, `{"a":[[1],[3,4,5],[]]}`},
+		{"array shrink", `[1,{"b":[2,3]},null,4]`, `[1,{"b":[]}]`},
+		{"root", `{"a":1}`, `[1,2]`},
+		{"root scalar", `1`, `"x"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := RawValue(tt.a), RawValue(tt.b)
+			forward, inverse, err := DiffInvertible(a, b)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := ApplyPatch(a, forward)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !Equal(got, b) {
+				t.Errorf("forward: got %s, want %s", got, b)
+			}
+
+			got, err = ApplyPatch(b, inverse)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !Equal(got, a) {
+				t.Errorf("inverse: got %s, want %s", got, a)
+			}
+		})
+	}
+}
+
+func TestDiffText(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want string
+	}{
+		{"equal", `{"a":[1]}`, `{"a":[1.0]}`, ``},
+		{"example", `{"user":{"name":"Alice"},"count":3}`, `{"user":{"nick":"Bob"},"count":4}`,
+			"~ /count: 3 -> 4\n- /user/name: \"Alice\"\n+ /user/nick: \"Bob\"\n"},
+		{"container", `{"a":{"b":1}}`, `{"a":[1]}`, "- /a: {\"b\":1}\n+ /a: [1]\n"},
+		{"array", `[1,2,3]`, `[1]`, "- /2: 3\n- /1: 2\n"},
+		{"root", `1`, `"1"`, "~ 1 -> \"1\"\n"},
+		{"root container", `null`, `{}`, "- null\n+ {}\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DiffText(RawValue(tt.a), RawValue(tt.b))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("DiffText() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+	if _, err := DiffText(RawValue(`{}`), RawValue(`[`)); err == nil {
+		t.Error("DiffText() of invalid JSON succeeded")
+	}
+}
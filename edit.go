@@ -0,0 +1,149 @@
+package jason
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+)
+
+// Set returns a copy of doc with value stored at the JSON Pointer ptr.
+//
+// Missing intermediate objects are created as needed.
+// Array elements can be replaced, but not created,
+// except by the "-" index, which appends to the array.
+// The input document is not modified.
+//
+// Example of setting the name of the first user:
+//
+//	jason.Set(doc, "/users/0/name", "Alice")
+func Set(doc RawValue, ptr string, value any) (RawValue, error) {
+	path, err := SplitPointer(ptr)
+	if err != nil {
+		return nil, err
+	}
+	tree, err := decodeTree(doc)
+	if err != nil {
+		return nil, err
+	}
+	tree, err = setTree(tree, path, 0, value)
+	if err != nil {
+		return nil, withOp("set", err)
+	}
+	return json.Marshal(tree)
+}
+
+func setTree(node any, path []string, i int, value any) (any, error) {
+	if i == len(path) {
+		return value, nil
+	}
+
+	tok := path[i]
+	switch node := node.(type) {
+	case Object:
+		child, ok := node[tok]
+		if !ok {
+			child = Object{}
+		}
+		child, err := setTree(child, path, i+1, value)
+		if err != nil {
+			return nil, err
+		}
+		node[tok] = child
+		return node, nil
+	case Array:
+		if tok == "-" {
+			child, err := setTree(Object{}, path, i+1, value)
+			if err != nil {
+				return nil, err
+			}
+			return append(node, child), nil
+		}
+		idx, err := arrayIndex(tok, len(node))
+		if err != nil {
+			return nil, pathError(path[:i+1], err)
+		}
+		child, err := setTree(node[idx], path, i+1, value)
+		if err != nil {
+			return nil, err
+		}
+		node[idx] = child
+		return node, nil
+	}
+	return nil, pathError(path[:i+1], errNotContainer)
+}
+
+// Delete returns a copy of doc without the value at the JSON Pointer ptr.
+//
+// Deleting an array element shifts subsequent elements down.
+// If ptr does not resolve to a value, a copy of doc is returned unchanged.
+// The input document is not modified.
+//
+// Example of redacting a field before logging:
+//
+//	jason.Delete(doc, "/user/password")
+func Delete(doc RawValue, ptr string) (RawValue, error) {
+	path, err := SplitPointer(ptr)
+	if err != nil {
+		return nil, err
+	}
+	if len(path) == 0 {
+		return nil, errors.New("jason: cannot delete the whole document")
+	}
+	tree, err := decodeTree(doc)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := getTree(tree, path); err != nil {
+		return bytes.Clone(doc), nil
+	}
+	tree, _, err = removeTree(tree, path)
+	if err != nil {
+		return nil, withOp("delete", err)
+	}
+	return json.Marshal(tree)
+}
+
+// Append returns a copy of doc with values appended
+// to the array at the JSON Pointer ptr.
+//
+// If ptr refers to a missing key of an existing object,
+// a new array is created.
+// Values are marshaled as by From.
+// The input document is not modified.
+//
+// Example of adding tags to a document:
+//
+//	jason.Append(doc, "/tags", "new", "hot")
+func Append(doc RawValue, ptr string, values ...any) (RawValue, error) {
+	path, err := SplitPointer(ptr)
+	if err != nil {
+		return nil, err
+	}
+	tree, err := decodeTree(doc)
+	if err != nil {
+		return nil, err
+	}
+	elems := make(Array, len(values))
+	for i, v := range values {
+		if elems[i], err = TryFrom(v); err != nil {
+			return nil, err
+		}
+	}
+
+	target, err := getTree(tree, path)
+	if err == nil {
+		arr, ok := target.(Array)
+		if !ok {
+			return nil, withOp("append", pathError(path, ErrNotArray))
+		}
+		tree, err = replaceTree(tree, path, append(arr, elems...))
+	} else if parent, perr := getTree(tree, path[:len(path)-1]); perr == nil {
+		if _, ok := parent.(Object); ok {
+			tree, err = addTree(tree, path, elems)
+		}
+	}
+	if err != nil {
+		return nil, withOp("append", err)
+	}
+	return json.Marshal(tree)
+}
@@ -0,0 +1,161 @@
+package jason
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSet(t *testing.T) {
+	doc := RawValue(`{"users":[{"name":"Bob"}],"n":null}`)
+	tests := []struct {
+		ptr     string
+		value   any
+		want    string
+		wantErr error
+	}{
+		{ptr: "/users/0/name", value: "Alice", want: `{"users":[{"name":"Alice"}],"n":null}`},
+		{ptr: "/users/-", value: 1, want: `{"users":[{"name":"Bob"},1],"n":null}`},
+		{ptr: "/users/-/name", value: "Eve", want: `{"users":[{"name":"Bob"},{"name":"Eve"}],"n":null}`},
+		{ptr: "/a/b/c", value: true, want: `{"users":[{"name":"Bob"}],"n":null,"a":{"b":{"c":true}}}`},
+		{ptr: "/n", value: Array{1}, want: `{"users":[{"name":"Bob"}],"n":[1]}`},
+		{ptr: "", value: 1, want: `1`},
+		{ptr: "/users/1", value: 1, wantErr: ErrIndexRange},
+		{ptr: "/users/x", value: 1, wantErr: errInvalidIndex},
+		{ptr: "/n/x", value: 1, wantErr: errNotContainer},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ptr, func(t *testing.T) {
+			got, err := Set(doc, tt.ptr, tt.value)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Set() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && !Equal(got, RawValue(tt.want)) {
+				t.Errorf("Set() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+	if string(doc) != `{"users":[{"name":"Bob"}],"n":null}` {
+		t.Errorf("Set() modified its input: %s", doc)
+	}
+	if _, err := Set(doc, "users", 1); err == nil {
+		t.Error("Set() accepted an invalid pointer")
+	}
+	if _, err := Set(RawValue(`{`), "/a", 1); err == nil {
+		t.Error("Set() accepted invalid JSON")
+	}
+}
+
+func TestPathError_edits(t *testing.T) {
+	doc := RawValue(`{"a":{"b":[1]},"s":"x"}`)
+	patch := func(op string) func() error {
+		return func() error {
+			_, err := ApplyPatch(doc, RawArray{RawValue(op)})
+			return err
+		}
+	}
+	tests := []struct {
+		name string
+		run  func() error
+		path string
+		op   string
+		err  error
+	}{
+		{"set", func() error { _, err := Set(doc, "/s/x", 1); return err }, "/s/x", "set", errNotContainer},
+		{"set index", func() error { _, err := Set(doc, "/a/b/5", 1); return err }, "/a/b/5", "set", ErrIndexRange},
+		{"append", func() error { _, err := Append(doc, "/s", 1); return err }, "/s", "append", ErrNotArray},
+		{"rename", func() error { _, err := RenameKeys(doc, map[string]string{"s": "a"}); return err }, "/a", "rename", errKeyCollision},
+		{"patch remove", patch(`{"op":"remove","path":"/a/c"}`), "/a/c", "remove", ErrNotFound},
+		{"patch test", patch(`{"op":"test","path":"/s","value":"y"}`), "/s", "test", errTestFailed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.run()
+			var perr *PathError
+			if !errors.As(err, &perr) {
+				t.Fatalf("got %v, want a *PathError", err)
+			}
+			if perr.Path != tt.path || perr.Op != tt.op || !errors.Is(err, tt.err) {
+				t.Errorf("got %q %q %v, want %q %q %v", perr.Path, perr.Op, perr.Err, tt.path, tt.op, tt.err)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	doc := RawValue(`{"user":{"name":"Bob","password":"x"},"tags":["a","b","c"]}`)
+	tests := []struct {
+		ptr     string
+		want    string
+		wantErr bool
+	}{
+		{ptr: "/user/password", want: `{"user":{"name":"Bob"},"tags":["a","b","c"]}`},
+		{ptr: "/tags/1", want: `{"user":{"name":"Bob","password":"x"},"tags":["a","c"]}`},
+		{ptr: "/tags", want: `{"user":{"name":"Bob","password":"x"}}`},
+		{ptr: "/missing", want: string(doc)},
+		{ptr: "/tags/3", want: string(doc)},
+		{ptr: "/user/name/x", want: string(doc)},
+		{ptr: "", wantErr: true},
+		{ptr: "user", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ptr, func(t *testing.T) {
+			got, err := Delete(doc, tt.ptr)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Delete() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !Equal(got, RawValue(tt.want)) {
+				t.Errorf("Delete() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDelete_missing(t *testing.T) {
+	doc := RawValue(`{"a":1}`)
+	got, err := Delete(doc, "/b")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got[2] = 'b'
+	if string(doc) != `{"a":1}` {
+		t.Errorf("modifying the result modified the input: %s", doc)
+	}
+}
+
+func TestAppend(t *testing.T) {
+	doc := RawValue(`{"tags":["a"],"user":{},"s":"x","list":[[]]}`)
+	tests := []struct {
+		ptr     string
+		values  []any
+		want    string
+		wantErr error
+	}{
+		{ptr: "/tags", values: []any{"b", "c"}, want: `{"tags":["a","b","c"],"user":{},"s":"x","list":[[]]}`},
+		{ptr: "/tags", want: string(doc)},
+		{ptr: "/user/roles", values: []any{"admin"}, want: `{"tags":["a"],"user":{"roles":["admin"]},"s":"x","list":[[]]}`},
+		{ptr: "/list/0", values: []any{Object{"k": 1}}, want: `{"tags":["a"],"user":{},"s":"x","list":[[{"k":1}]]}`},
+		{ptr: "/s", values: []any{1}, wantErr: ErrNotArray},
+		{ptr: "/user", values: []any{1}, wantErr: ErrNotArray},
+		{ptr: "/list/1", values: []any{1}, wantErr: ErrIndexRange},
+		{ptr: "/missing/roles", values: []any{1}, wantErr: ErrNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ptr, func(t *testing.T) {
+			got, err := Append(doc, tt.ptr, tt.values...)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Append() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && !Equal(got, RawValue(tt.want)) {
+				t.Errorf("Append() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	got, err := Append(RawValue(`[1]`), "", 2)
+	if err != nil || string(got) != `[1,2]` {
+		t.Errorf("Append() = %s, %v, want [1,2]", got, err)
+	}
+	if _, err := Append(doc, "/tags", func() {}); err == nil {
+		t.Error("Append() accepted an unmarshalable value")
+	}
+}
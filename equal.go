@@ -0,0 +1,273 @@
+package jason
+
+import (
+	"math"
+	"reflect"
+	"strconv"
+)
+
+// Equal reports whether a and b are semantically equal JSON values.
+//
+// Objects are equal regardless of key order,
+// numbers are compared by their exact mathematical value,
+// and strings by their unescaped contents.
+// Malformed input is never equal to anything.
+//
+// Example of comparing two documents:
+//
+//	jason.Equal(jason.RawValue(`{"a":1e2}`), jason.RawValue(`{ "a": 100 }`))
+func Equal(a, b RawValue) bool {
+	x, err := decodeTree(a)
+	if err != nil {
+		return false
+	}
+	y, err := decodeTree(b)
+	if err != nil {
+		return false
+	}
+	return equal(x, y)
+}
+
+// equal reports whether two decoded trees are canonically equal:
+// numbers are compared by value, objects regardless of key order.
+// Numbers can be Number, or any Go numeric type.
+func equal(a, b any) bool {
+	return equalWith(a, b, equalNumber)
+}
+
+// equalWith is like equal, but compares numbers with num.
+func equalWith(a, b any, num func(a, b Number) bool) bool {
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case bool:
+		b, ok := b.(bool)
+		return ok && a == b
+	case string:
+		b, ok := b.(string)
+		return ok && a == b
+	case Number:
+		switch b := b.(type) {
+		case Number:
+			return num(a, b)
+		case float64:
+			return num(a, floatNumber(b))
+		}
+		if n, ok := goNumber(b); ok {
+			return num(a, n)
+		}
+		return false
+	case float64:
+		return equalWith(floatNumber(a), b, num)
+	case Array:
+		b, ok := b.(Array)
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if !equalWith(a[i], b[i], num) {
+				return false
+			}
+		}
+		return true
+	case Object:
+		b, ok := b.(Object)
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for k, v := range a {
+			w, ok := b[k]
+			if !ok || !equalWith(v, w, num) {
+				return false
+			}
+		}
+		return true
+	}
+	if n, ok := goNumber(a); ok {
+		return equalWith(n, b, num)
+	}
+	return false
+}
+
+// goNumber converts v into a Number, if v is of a Go numeric type,
+// formatted as by [json.Marshal].
+func goNumber(v any) (Number, bool) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return Number(strconv.FormatInt(rv.Int(), 10)), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return Number(strconv.FormatUint(rv.Uint(), 10)), true
+	case reflect.Float32:
+		return Number(strconv.FormatFloat(rv.Float(), 'g', -1, 32)), true
+	case reflect.Float64:
+		return floatNumber(rv.Float()), true
+	}
+	return "", false
+}
+
+func floatNumber(f float64) Number {
+	return Number(strconv.FormatFloat(f, 'g', -1, 64))
+}
+
+func equalNumber(a, b Number) bool {
+	if a == b {
+		return true
+	}
+	x, err := ToBigRat(a)
+	if err != nil {
+		return false
+	}
+	y, err := ToBigRat(b)
+	if err != nil {
+		return false
+	}
+	return x.Cmp(y) == 0
+}
+
+// Tolerance sets how EqualApprox compares numbers.
+type Tolerance int
+
+const (
+	// AbsoluteTolerance compares numbers as equal
+	// if they differ by at most epsilon (the default).
+	AbsoluteTolerance Tolerance = iota
+	// RelativeTolerance compares numbers as equal
+	// if they differ by at most epsilon times the larger magnitude.
+	RelativeTolerance
+)
+
+// EqualApprox reports whether a and b are equal JSON values, like Equal,
+// but with numbers compared as equal if they are within epsilon,
+// as set by a Tolerance.
+//
+// Numbers are compared as float64s, unless they are exactly equal.
+// Strings, booleans, nulls and structure are compared exactly.
+//
+// Example of comparing the results of floating point computations:
+//
+//	jason.EqualApprox(got, want, 1e-9, jason.RelativeTolerance)
+func EqualApprox(a, b RawValue, epsilon float64, tol ...Tolerance) bool {
+	relative := false
+	for _, t := range tol {
+		relative = t == RelativeTolerance
+	}
+
+	x, err := decodeTree(a)
+	if err != nil {
+		return false
+	}
+	y, err := decodeTree(b)
+	if err != nil {
+		return false
+	}
+	return equalWith(x, y, func(a, b Number) bool {
+		if equalNumber(a, b) {
+			return true
+		}
+		x, err := a.Float64()
+		if err != nil {
+			return false
+		}
+		y, err := b.Float64()
+		if err != nil {
+			return false
+		}
+		diff := math.Abs(x - y)
+		if relative {
+			return diff <= epsilon*math.Max(math.Abs(x), math.Abs(y))
+		}
+		return diff <= epsilon
+	})
+}
+
+// EqualExcept reports whether a and b are equal JSON values, like Equal,
+// but ignoring the values at paths matching any of patterns, as by Redact.
+//
+// Object members at matching paths are ignored on both sides,
+// whether or not they are present in both;
+// array elements at matching paths compare equal,
+// but arrays must still have the same length.
+// Invalid patterns are never equal.
+//
+// Example of comparing API responses with volatile fields:
+//
+//	jason.EqualExcept(got, want, "/timestamp", "/requestId", "/items/*/etag")
+func EqualExcept(a, b RawValue, patterns ...string) bool {
+	m, err := newMatcher(patterns)
+	if err != nil {
+		return false
+	}
+	x, err := decodeTree(a)
+	if err != nil {
+		return false
+	}
+	y, err := decodeTree(b)
+	if err != nil {
+		return false
+	}
+	cs := m.start()
+	if m.matched(cs) {
+		return true
+	}
+	return equal(m.ignoreTree(x, cs), m.ignoreTree(y, cs))
+}
+
+// Contains reports whether sub is structurally contained in sup.
+//
+// An object contains another if it has every key of the other,
+// with a value that contains the value of the other.
+// An array contains another if, for every element of the other,
+// some element of the array contains it: elements are matched
+// regardless of order, and several of them may match the same element,
+// so [1, 2] contains [2, 1] and [1, 1], but not [3].
+// Other values contain those they are equal to, as by Equal.
+// Malformed input is never contained in anything.
+//
+// Example of checking a response has at least the expected fields:
+//
+//	jason.Contains(resp, jason.RawValue(`{"user":{"name":"Alice"},"tags":["admin"]}`))
+func Contains(sup, sub RawValue) bool {
+	x, err := decodeTree(sup)
+	if err != nil {
+		return false
+	}
+	y, err := decodeTree(sub)
+	if err != nil {
+		return false
+	}
+	return contains(x, y)
+}
+
+func contains(sup, sub any) bool {
+	switch sub := sub.(type) {
+	case Object:
+		sup, ok := sup.(Object)
+		if !ok {
+			return false
+		}
+		for k, v := range sub {
+			w, ok := sup[k]
+			if !ok || !contains(w, v) {
+				return false
+			}
+		}
+		return true
+	case Array:
+		sup, ok := sup.(Array)
+		if !ok {
+			return false
+		}
+	elements:
+		for _, v := range sub {
+			for _, w := range sup {
+				if contains(w, v) {
+					continue elements
+				}
+			}
+			return false
+		}
+		return true
+	}
+	return equal(sup, sub)
+}
@@ -0,0 +1,145 @@
+package jason
+
+import "testing"
+
+func TestEqual(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{`{"a":1e2}`, `{ "a": 100 }`, true},
+		{`{"a":1,"b":2}`, `{"b":2,"a":1}`, true},
+		{`[1,2]`, `[2,1]`, false},
+		{`[1]`, `[1,1]`, false},
+		{`"A"`, `"A"`, true},
+		{`1.0`, `1`, true},
+		{`0.1`, `0.10000000000000001`, false},
+		{`-0`, `0`, true},
+		{`null`, `null`, true},
+		{`null`, `false`, false},
+		{`{"a":null}`, `{}`, false},
+		{`{"a":[{"b":1}]}`, `{"a":[{"b":1.0}]}`, true},
+		{`1`, `"1"`, false},
+		{`{`, `{`, false},
+		{`1`, `1 2`, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+" "+tt.b, func(t *testing.T) {
+			if got := Equal(RawValue(tt.a), RawValue(tt.b)); got != tt.want {
+				t.Errorf("Equal() = %v, want %v", got, tt.want)
+			}
+			if got := Equal(RawValue(tt.b), RawValue(tt.a)); got != tt.want {
+				t.Errorf("Equal() reversed = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEqual_goValues(t *testing.T) {
+	tests := []struct {
+		a, b any
+		want bool
+	}{
+		{Number("1"), 1, true},
+		{1.5, Number("1.50"), true},
+		{int8(2), uint64(2), true},
+		{float32(0.5), Number("0.5"), true},
+		{Array{1, "a"}, Array{Number("1"), "a"}, true},
+		{Object{"a": 1}, Object{"a": 2}, false},
+		{1, "1", false},
+	}
+	for _, tt := range tests {
+		if got := equal(tt.a, tt.b); got != tt.want {
+			t.Errorf("equal(%v, %v) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestEqualApprox(t *testing.T) {
+	tests := []struct {
+		a, b    string
+		epsilon float64
+		tol     []Tolerance
+		want    bool
+	}{
+		{`1`, `1.0`, 0, nil, true},
+		{`0.1`, `0.10000001`, 1e-6, nil, true},
+		{`0.1`, `0.1001`, 1e-6, nil, false},
+		{`[1, {"a": 2}]`, `[1.0000001, {"a": 1.9999999}]`, 1e-6, nil, true},
+		{`1000000`, `1000001`, 1e-6, nil, false},
+		{`1000000`, `1000001`, 1e-6, []Tolerance{RelativeTolerance}, true},
+		{`0.001`, `0.0011`, 1e-3, []Tolerance{RelativeTolerance}, false},
+		{`-1`, `1`, 2, nil, true},
+		{`1e400`, `1e400`, 0, nil, true},
+		{`1e400`, `2e400`, 1e300, nil, false},
+		{`"1"`, `"1.0"`, 1, nil, false},
+		{`[1]`, `[1, 1]`, 1, nil, false},
+		{`{"a": 1}`, `{"b": 1}`, 1, nil, false},
+		{`1`, `null`, 1, nil, false},
+		{`[1`, `[1`, 1, nil, false},
+	}
+	for _, tt := range tests {
+		if got := EqualApprox(RawValue(tt.a), RawValue(tt.b), tt.epsilon, tt.tol...); got != tt.want {
+			t.Errorf("EqualApprox(%s, %s, %g, %v) = %v, want %v", tt.a, tt.b, tt.epsilon, tt.tol, got, tt.want)
+		}
+	}
+}
+
+func TestEqualExcept(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		patterns []string
+		want     bool
+	}{
+		{`{"a":1,"ts":1}`, `{"a":1,"ts":2}`, []string{"/ts"}, true},
+		{`{"a":1,"ts":1}`, `{"a":1}`, []string{"/ts"}, true},
+		{`{"a":1,"ts":1}`, `{"a":2,"ts":1}`, []string{"/ts"}, false},
+		{`{"items":[{"id":1,"etag":"x"},{"id":2,"etag":"y"}]}`, `{"items":[{"id":1,"etag":"z"},{"id":2}]}`, []string{"/items/*/etag"}, true},
+		{`{"items":[{"id":1,"etag":"x"}]}`, `{"items":[{"id":2,"etag":"x"}]}`, []string{"/items/*/etag"}, false},
+		{`[1,2,3]`, `[1,5,3]`, []string{"/1"}, true},
+		{`[1,2,3]`, `[1,2]`, []string{"/2"}, false},
+		{`{"a":{"id":1},"b":[{"id":2}]}`, `{"a":{"id":3},"b":[{"id":4}]}`, []string{"/**/id"}, true},
+		{`1`, `2`, []string{""}, true},
+		{`{"a":1}`, `{"a":1.0}`, nil, true},
+		{`{"a":1}`, `{"a":1}`, []string{"a"}, false},
+		{`{"a":1}`, `{"a":`, []string{"/a"}, false},
+	}
+	for _, tt := range tests {
+		if got := EqualExcept(RawValue(tt.a), RawValue(tt.b), tt.patterns...); got != tt.want {
+			t.Errorf("EqualExcept(%s, %s, %q) = %v, want %v", tt.a, tt.b, tt.patterns, got, tt.want)
+		}
+	}
+}
+
+func TestContains(t *testing.T) {
+	tests := []struct {
+		sup, sub string
+		want     bool
+	}{
+		{`{"user":{"name":"Alice","id":1},"tags":["admin","dev"]}`, `{"user":{"name":"Alice"},"tags":["admin"]}`, true},
+		{`{"user":{"name":"Alice"}}`, `{"user":{"name":"Bob"}}`, false},
+		{`{"a":1}`, `{"a":1,"b":2}`, false},
+		{`{"a":1}`, `{}`, true},
+		{`[1,2]`, `[2,1]`, true},
+		{`[1,2]`, `[1,1]`, true},
+		{`[1,2]`, `[3]`, false},
+		{`[1,2]`, `[]`, true},
+		{`[]`, `[1]`, false},
+		{`[{"a":1,"b":2},{"c":3}]`, `[{"c":3},{"a":1}]`, true},
+		{`[[1,2],[3]]`, `[[2]]`, true},
+		{`1`, `1.0`, true},
+		{`"a"`, `"a"`, true},
+		{`null`, `null`, true},
+		{`{"a":null}`, `{"a":null}`, true},
+		{`{"a":null}`, `{"b":null}`, false},
+		{`[1]`, `1`, false},
+		{`{"a":[1]}`, `{"a":1}`, false},
+		{`{"a":1}`, `{"a":`, false},
+		{`{"a":`, `{}`, false},
+	}
+	for _, tt := range tests {
+		if got := Contains(RawValue(tt.sup), RawValue(tt.sub)); got != tt.want {
+			t.Errorf("Contains(%s, %s) = %v, want %v", tt.sup, tt.sub, got, tt.want)
+		}
+	}
+}
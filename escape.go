@@ -0,0 +1,163 @@
+package jason
+
+import (
+	"bytes"
+	"fmt"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// AppendString appends the unescaped contents of the JSON string j to dst,
+// and returns the extended buffer.
+//
+// It is like AsA[string], but avoids allocating a string for the result.
+// As with [json.Unmarshal], invalid UTF-8 and unpaired surrogates
+// are replaced by U+FFFD.
+// If j is not a string, dst is returned unchanged, with an error.
+//
+// Example of concatenating string fields into a reused buffer:
+//
+//	buf, err = jason.AppendString(buf[:0], o["first"])
+func AppendString(dst []byte, j RawValue) ([]byte, error) {
+	j = bytes.TrimSpace(j)
+	switch k := KindOf(j); k {
+	case StringKind:
+	case InvalidKind:
+		return dst, Validate(j)
+	default:
+		return dst, fmt.Errorf("jason: cannot convert %s into string", k)
+	}
+
+	// j is a valid string: quotes and escapes are well formed.
+	s := j[1 : len(j)-1]
+	for len(s) > 0 {
+		i := bytes.IndexByte(s, '\\')
+		if i < 0 {
+			i = len(s)
+		}
+		dst = appendValidUTF8(dst, s[:i])
+		if s = s[i:]; len(s) == 0 {
+			break
+		}
+
+		switch c := s[1]; c {
+		case 'b':
+			dst = append(dst, '\b')
+		case 'f':
+			dst = append(dst, '\f')
+		case 'n':
+			dst = append(dst, '\n')
+		case 'r':
+			dst = append(dst, '\r')
+		case 't':
+			dst = append(dst, '\t')
+		case 'u':
+			r := hexRune(s[2:6])
+			s = s[4:]
+			if utf16.IsSurrogate(r) {
+				r2 := utf8.RuneError
+				if len(s) >= 8 && s[2] == '\\' && s[3] == 'u' {
+					r2 = hexRune(s[4:8])
+				}
+				if r = utf16.DecodeRune(r, r2); r != utf8.RuneError {
+					s = s[6:]
+				}
+			}
+			dst = utf8.AppendRune(dst, r)
+		default: // '"', '\\', '/'
+			dst = append(dst, c)
+		}
+		s = s[2:]
+	}
+	return dst, nil
+}
+
+// appendValidUTF8 appends s to dst, replacing invalid UTF-8 by U+FFFD.
+func appendValidUTF8(dst, s []byte) []byte {
+	for len(s) > 0 {
+		i := 0
+		for i < len(s) && s[i] < utf8.RuneSelf {
+			i++
+		}
+		dst, s = append(dst, s[:i]...), s[i:]
+		if len(s) > 0 {
+			r, size := utf8.DecodeRune(s)
+			dst, s = utf8.AppendRune(dst, r), s[size:]
+		}
+	}
+	return dst
+}
+
+func hexRune(h []byte) rune {
+	var r rune
+	for _, c := range h {
+		switch {
+		case '0' <= c && c <= '9':
+			c -= '0'
+		case 'a' <= c && c <= 'f':
+			c -= 'a' - 10
+		case 'A' <= c && c <= 'F':
+			c -= 'A' - 10
+		}
+		r = r<<4 | rune(c)
+	}
+	return r
+}
+
+// AppendEscaped appends s to dst as a quoted JSON string,
+// escaped as by [json.Marshal], and returns the extended buffer.
+//
+// Example of writing a string field into a reused buffer:
+//
+//	buf = jason.AppendEscaped(append(buf, `"name":`...), name)
+func AppendEscaped(dst []byte, s string) []byte {
+	const hex = "0123456789abcdef"
+
+	dst = append(dst, '"')
+	start := 0
+	for i := 0; i < len(s); {
+		if c := s[i]; c < utf8.RuneSelf {
+			if c >= ' ' && c != '"' && c != '\\' && c != '<' && c != '>' && c != '&' {
+				i++
+				continue
+			}
+			dst = append(dst, s[start:i]...)
+			switch c {
+			case '"', '\\':
+				dst = append(dst, '\\', c)
+			case '\b':
+				dst = append(dst, '\\', 'b')
+			case '\f':
+				dst = append(dst, '\\', 'f')
+			case '\n':
+				dst = append(dst, '\\', 'n')
+			case '\r':
+				dst = append(dst, '\\', 'r')
+			case '\t':
+				dst = append(dst, '\\', 't')
+			default:
+				dst = append(dst, '\\', 'u', '0', '0', hex[c>>4], hex[c&0xf])
+			}
+			i++
+			start = i
+			continue
+		}
+
+		r, size := utf8.DecodeRuneInString(s[i:])
+		switch {
+		case r == utf8.RuneError && size == 1:
+			dst = append(dst, s[start:i]...)
+			dst = utf8.AppendRune(dst, utf8.RuneError)
+		case r == '\u2028' || r == '\u2029':
+			dst = append(dst, s[start:i]...)
+			dst = append(dst, '\\', 'u', '2', '0', '2', hex[r&0xf])
+		default:
+			i += size
+			continue
+		}
+		i += size
+		start = i
+	}
+	dst = append(dst, s[start:]...)
+	return append(dst, '"')
+}
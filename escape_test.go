@@ -0,0 +1,71 @@
+package jason
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+var escapeTests = []string{
+	"",
+	"plain",
+	"quote \" backslash \\ slash /",
+	"\b\f\n\r\t\x00\x1f",
+	"<a href='x'>&amp;</a>",
+	"héllo, 世界 😀",
+	"  ",
+	"bad \xff utf-8 \xe2\x82",
+}
+
+func TestAppendEscaped(t *testing.T) {
+	for _, s := range escapeTests {
+		want, err := json.Marshal(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := AppendEscaped([]byte("x"), s); string(got) != "x"+string(want) {
+			t.Errorf("AppendEscaped(%q) = %s, want x%s", s, got, want)
+		}
+	}
+}
+
+func TestAppendString(t *testing.T) {
+	inputs := []string{
+		` "a" `,
+		`"\"\\\/\b\f\n\r\t"`,
+		`"é世😀"`,
+		`"\ud83d"`,
+		`"\ud83dx"`,
+		`"\ude00\ud83dA"`,
+		`"\ud83dA"`,
+		"\"bad \xff utf-8\"",
+	}
+	for _, s := range escapeTests {
+		j, _ := json.Marshal(s)
+		inputs = append(inputs, string(j))
+	}
+	for _, in := range inputs {
+		var want string
+		if err := json.Unmarshal([]byte(in), &want); err != nil {
+			t.Fatal(err)
+		}
+		got, err := AppendString([]byte("x"), RawValue(in))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != "x"+want {
+			t.Errorf("AppendString(%s) = %q, want %q", in, got, "x"+want)
+		}
+	}
+}
+
+func TestAppendString_errors(t *testing.T) {
+	for _, in := range []string{``, `1`, `null`, `"a`, `"a" "b"`, `"\x"`} {
+		got, err := AppendString([]byte("x"), RawValue(in))
+		if err == nil {
+			t.Errorf("AppendString(%s) succeeded", in)
+		}
+		if string(got) != "x" {
+			t.Errorf("AppendString(%s) = %q, want dst unchanged", in, got)
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package jason
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Flatten collapses the nested object o into a single level map,
+// keyed by the dot separated paths accepted by Get.
+//
+// Scalars become the values of the map.
+// Nested empty objects and arrays are kept as values,
+// an empty Object or Array keyed by their path,
+// so that Unflatten restores them;
+// an empty o flattens to an empty map.
+//
+// Example of flattening an object:
+//
+//	jason.Flatten(jason.Object{"a": jason.Object{"b": jason.Array{1, 2}}})
+//	// map[a.b[0]:1 a.b[1]:2]
+func Flatten(o Object) map[string]any {
+	m := map[string]any{}
+	if len(o) > 0 {
+		flatten(m, nil, o)
+	}
+	return m
+}
+
+func flatten(m map[string]any, path []segment, v any) {
+	switch v := v.(type) {
+	case Object:
+		if len(v) > 0 {
+			for k, e := range v {
+				flatten(m, append(path, segment{key: k, index: -1}), e)
+			}
+			return
+		}
+	case Array:
+		if len(v) > 0 {
+			for i, e := range v {
+				flatten(m, append(path, segment{index: i}), e)
+			}
+			return
+		}
+	}
+	m[formatPath(path)] = v
+}
+
+// Unflatten reverses Flatten, expanding the paths keying m
+// into nested objects and arrays.
+//
+// Keys are processed in path order, with array indices sorted
+// numerically, and later keys replacing any conflicting values
+// set by earlier ones.
+// Array indices must not skip elements: an index can be at most
+// the length of the array so far, which appends to it.
+// Keys that are not valid paths, or skip array elements,
+// are used verbatim.
+//
+// Example of unflattening a map:
+//
+//	jason.Unflatten(map[string]any{"a.b[0]": 1, "a.b[1]": 2})
+//	// map[a:map[b:[1 2]]]
+func Unflatten(m map[string]any) Object {
+	type entry struct {
+		key  string
+		segs []segment
+	}
+	entries := make([]entry, 0, len(m))
+	for k := range m {
+		segs, err := parsePath(k)
+		if err != nil || len(segs) == 0 || segs[0].index >= 0 {
+			segs = []segment{{key: k, index: -1}}
+		}
+		entries = append(entries, entry{k, segs})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return lessPath(entries[i].segs, entries[j].segs)
+	})
+
+	o := Object{}
+	for _, e := range entries {
+		if _, err := unflatten(o, e.segs, m[e.key]); err != nil {
+			unflatten(o, []segment{{key: e.key, index: -1}}, m[e.key])
+		}
+	}
+	return o
+}
+
+// lessPath orders paths segment by segment,
+// keys before indices, and indices numerically.
+func lessPath(a, b []segment) bool {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		x, y := a[i], b[i]
+		switch {
+		case x.index < 0 && y.index < 0:
+			if x.key != y.key {
+				return x.key < y.key
+			}
+		case x.index != y.index:
+			return x.index < y.index
+		}
+	}
+	return len(a) < len(b)
+}
+
+// unflatten sets the value at path within node to v,
+// and returns the updated node.
+// Nothing is modified if path skips array elements.
+func unflatten(node any, path []segment, v any) (any, error) {
+	if len(path) == 0 {
+		switch e := v.(type) {
+		case Object:
+			if len(e) == 0 {
+				return Object{}, nil
+			}
+		case Array:
+			if len(e) == 0 {
+				return Array{}, nil
+			}
+		}
+		return v, nil
+	}
+
+	seg := path[0]
+	if seg.index < 0 {
+		o, ok := node.(Object)
+		if !ok {
+			o = Object{}
+		}
+		child, err := unflatten(o[seg.key], path[1:], v)
+		if err != nil {
+			return nil, err
+		}
+		o[seg.key] = child
+		return o, nil
+	}
+
+	a, _ := node.(Array)
+	if seg.index > len(a) {
+		return nil, fmt.Errorf("%w (length %d)", ErrIndexRange, len(a))
+	}
+	var child any
+	if seg.index < len(a) {
+		child = a[seg.index]
+	}
+	child, err := unflatten(child, path[1:], v)
+	if err != nil {
+		return nil, err
+	}
+	if seg.index == len(a) {
+		return append(a, child), nil
+	}
+	a[seg.index] = child
+	return a, nil
+}
@@ -0,0 +1,54 @@
+package jason
+
+import "testing"
+
+func TestFlatten(t *testing.T) {
+	tests := []struct {
+		name string
+		in   Object
+		want map[string]any
+	}{
+		{"empty", Object{}, map[string]any{}},
+		{"nil", nil, map[string]any{}},
+		{"empty key", Object{"": 1}, map[string]any{"": 1}},
+		{"nested", Object{"a": Object{"b": Array{1, 2}}}, map[string]any{"a.b[0]": 1, "a.b[1]": 2}},
+		{"nested empty", Object{"a": Object{}, "b": Array{}}, map[string]any{"a": Object{}, "b": Array{}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Flatten(tt.in)
+			if !equal(Object(got), Object(tt.want)) {
+				t.Errorf("Flatten = %v, want %v", got, tt.want)
+			}
+			if back := Unflatten(got); !equal(back, tt.in) {
+				t.Errorf("Unflatten = %v, want %v", back, tt.in)
+			}
+		})
+	}
+}
+
+func TestUnflatten(t *testing.T) {
+	long := Array{}
+	for i := range 12 {
+		long = append(long, i)
+	}
+	tests := []struct {
+		name string
+		in   map[string]any
+		want Object
+	}{
+		{"long array", Flatten(Object{"a": long}), Object{"a": long}},
+		{"nested arrays", map[string]any{"a[1][0]": 2, "a[0][0]": 1, "a[0][1]": 1}, Object{"a": Array{Array{1, 1}, Array{2}}}},
+		{"conflict", map[string]any{"a": 1, "a.b": 2}, Object{"a": Object{"b": 2}}},
+		{"skips index", map[string]any{"a[0]": 1, "a[2]": 3}, Object{"a": Array{1}, "a[2]": 3}},
+		{"huge index", map[string]any{"a[99999999999]": 1}, Object{"a[99999999999]": 1}},
+		{"invalid path", map[string]any{"a[x]": 1}, Object{"a[x]": 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Unflatten(tt.in); !equal(got, tt.want) {
+				t.Errorf("Unflatten = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
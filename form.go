@@ -0,0 +1,171 @@
+package jason
+
+import (
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ToValues encodes the nested object o as form values.
+//
+// Nested keys are written in brackets, and array elements
+// by their index, so {"a":{"b":[1,2]}} is encoded as a[b][0]=1&a[b][1]=2.
+// Strings are encoded as is, other scalars as their JSON text,
+// and null as the empty string.
+// Empty objects and arrays are omitted.
+//
+// Example of posting an object as a form:
+//
+//	http.PostForm(url, jason.ToValues(o))
+func ToValues(o Object) url.Values {
+	v := url.Values{}
+	for k, e := range o {
+		toValues(v, k, e)
+	}
+	return v
+}
+
+func toValues(v url.Values, key string, e any) {
+	switch e := e.(type) {
+	case Object:
+		for k, c := range e {
+			toValues(v, key+"["+k+"]", c)
+		}
+	case Array:
+		for i, c := range e {
+			toValues(v, key+"["+strconv.Itoa(i)+"]", c)
+		}
+	case string:
+		v.Add(key, e)
+	case nil:
+		v.Add(key, "")
+	default:
+		v.Add(key, string(From(e)))
+	}
+}
+
+// FromValues decodes form values into a nested object,
+// reversing ToValues.
+//
+// Bracketed keys are expanded into nested objects,
+// or arrays if the bracketed segment is an index.
+// Indices must not skip elements: an index past the end of an array
+// turns the array into an object, keyed by its indices as strings.
+// The empty segment, as in a[]=1&a[]=2, appends to an array.
+// Keys with multiple values become arrays, other keys
+// decode to a single value.
+// Keys that are not well formed are used verbatim.
+// As with Unflatten, keys are processed in path order,
+// with indices sorted numerically.
+//
+// All values are decoded as strings;
+// Coerce can convert them to other types.
+//
+// Example of decoding a submitted form:
+//
+//	r.ParseForm()
+//	o := jason.FromValues(r.PostForm)
+func FromValues(v url.Values) Object {
+	type entry struct {
+		key  string
+		segs []string
+	}
+	entries := make([]entry, 0, len(v))
+	for k := range v {
+		entries = append(entries, entry{k, parseFormKey(k)})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return lessFormKey(entries[i].segs, entries[j].segs)
+	})
+
+	o := Object{}
+	for _, e := range entries {
+		vals := v[e.key]
+		if len(vals) == 0 {
+			continue
+		}
+		segs := e.segs
+		if segs[len(segs)-1] == "" {
+			for _, e := range vals {
+				fromValues(o, segs, e)
+			}
+		} else if len(vals) == 1 {
+			fromValues(o, segs, vals[0])
+		} else {
+			arr := make(Array, len(vals))
+			for i, e := range vals {
+				arr[i] = e
+			}
+			fromValues(o, segs, arr)
+		}
+	}
+	return o
+}
+
+func fromValues(node any, segs []string, v any) any {
+	if len(segs) == 0 {
+		return v
+	}
+
+	seg := segs[0]
+	o, ok := node.(Object)
+	if !ok {
+		a, _ := node.(Array)
+		if seg == "" {
+			return append(a, fromValues(nil, segs[1:], v))
+		}
+		if idx, ok := parseIndex(seg); ok && idx <= len(a) {
+			if idx == len(a) {
+				return append(a, fromValues(nil, segs[1:], v))
+			}
+			a[idx] = fromValues(a[idx], segs[1:], v)
+			return a
+		}
+		o = make(Object, len(a))
+		for i, e := range a {
+			o[strconv.Itoa(i)] = e
+		}
+	}
+	o[seg] = fromValues(o[seg], segs[1:], v)
+	return o
+}
+
+// parseFormKey splits a key like a[b][0] into its segments.
+// Keys that are not well formed are returned as a single segment.
+func parseFormKey(key string) []string {
+	i := strings.IndexByte(key, '[')
+	if i <= 0 {
+		return []string{key}
+	}
+	segs := []string{key[:i]}
+	for rest := key[i:]; rest != ""; {
+		end := strings.IndexByte(rest, ']')
+		if rest[0] != '[' || end < 0 || strings.IndexByte(rest[1:end], '[') >= 0 {
+			return []string{key}
+		}
+		segs = append(segs, rest[1:end])
+		rest = rest[end+1:]
+	}
+	return segs
+}
+
+// lessFormKey orders keys split by parseFormKey segment by segment,
+// indices before other segments, and indices numerically.
+func lessFormKey(a, b []string) bool {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i] == b[i] {
+			continue
+		}
+		x, xok := parseIndex(a[i])
+		y, yok := parseIndex(b[i])
+		switch {
+		case xok && yok:
+			return x < y
+		case xok != yok:
+			return xok
+		}
+		return a[i] < b[i]
+	}
+	return len(a) < len(b)
+}
@@ -0,0 +1,58 @@
+package jason
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestFromValues(t *testing.T) {
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{"a=1", `{"a":"1"}`},
+		{"a=1&a=2", `{"a":["1","2"]}`},
+		{"a[b][0]=1&a[b][1]=2", `{"a":{"b":["1","2"]}}`},
+		{"a[]=1&a[]=2", `{"a":["1","2"]}`},
+		{"a[1]=y&a[0]=x", `{"a":["x","y"]}`},
+		{"a[0]=x&a[5]=y", `{"a":{"0":"x","5":"y"}}`},
+		{"a[999999999999]=x", `{"a":{"999999999999":"x"}}`},
+		{"a[99999999999999999999]=x", `{"a":{"99999999999999999999":"x"}}`},
+		{"a[01]=x", `{"a":{"01":"x"}}`},
+		{"a[b=1", `{"a[b":"1"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			v, err := url.ParseQuery(tt.query)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := From(FromValues(v)); !Equal(got, RawValue(tt.want)) {
+				t.Errorf("FromValues() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToValues(t *testing.T) {
+	long := Array{}
+	for i := range 12 {
+		long = append(long, string(rune('a'+i)))
+	}
+	tests := []struct {
+		name string
+		in   Object
+	}{
+		{"scalars", Object{"s": "x", "n": "1"}},
+		{"nested", Object{"a": Object{"b": Array{"1", "2"}}}},
+		{"long array", Object{"a": long}},
+		{"array of objects", Object{"a": Array{Object{"b": "1"}, Object{"b": "2"}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FromValues(ToValues(tt.in)); !equal(got, tt.in) {
+				t.Errorf("FromValues(ToValues()) = %v, want %v", got, tt.in)
+			}
+		})
+	}
+}
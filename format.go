@@ -0,0 +1,87 @@
+package jason
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Compact returns j without insignificant whitespace.
+//
+// Example of compacting a value before storing it:
+//
+//	jason.Compact(jason.RawValue(`{ "a": [1, 2] }`))
+func Compact(j RawValue) (RawValue, error) {
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, j); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Indent returns an indented form of j.
+//
+// Each element in a JSON object or array begins on a new,
+// indented line beginning with prefix followed by one or more
+// copies of indent according to the indentation nesting.
+//
+// Example of pretty-printing a value:
+//
+//	jason.Indent(j, "", "\t")
+func Indent(j RawValue, prefix, indent string) (RawValue, error) {
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, j, prefix, indent); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// IsCompact reports whether j is valid JSON
+// without insignificant whitespace, as returned by Compact.
+func IsCompact(j RawValue) bool {
+	str := false
+	for i := 0; i < len(j); i++ {
+		switch c := j[i]; {
+		case str && c == '\\':
+			i++
+		case c == '"':
+			str = !str
+		case !str && (c == ' ' || c == '\t' || c == '\r' || c == '\n'):
+			return false
+		}
+	}
+	return json.Valid(j)
+}
+
+// Normalize compacts j, and sorts the keys of its objects.
+//
+// Unlike Canonical, which implements RFC 8785,
+// numbers are left as written, keys are sorted byte-wise,
+// and strings are escaped as by [json.Marshal],
+// so the result is not a canonical form across implementations.
+// Of duplicate keys, the last one wins.
+//
+// Example of computing a cache key:
+//
+//	key, err := jason.Normalize(j)
+func Normalize(j RawValue) (RawValue, error) {
+	tree, err := decodeTree(j)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(tree)
+}
+
+// FromSorted marshals v into a RawValue, like TryFrom,
+// but with the keys of every object sorted, as by Normalize,
+// regardless of how v represents them, e.g. as an OrderedObject.
+//
+// Example of writing a deterministic golden file:
+//
+//	j, err := jason.FromSorted(v)
+func FromSorted(v any) (RawValue, error) {
+	j, err := TryFrom(v)
+	if err != nil {
+		return nil, err
+	}
+	return Normalize(j)
+}
@@ -0,0 +1,130 @@
+package jason
+
+import "testing"
+
+func TestCompact(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{in: ` { "a" : [ 1 , 2 ] , "b" : " x y " } `, want: `{"a":[1,2],"b":" x y "}`},
+		{in: "[\n\t1.50\r\n]", want: `[1.50]`},
+		{in: `"<&>"`, want: `"<&>"`},
+		{in: `{`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := Compact(RawValue(tt.in))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Compact() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Compact() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIndent(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{in: `{"a":[1,2],"b":{}}`, want: "{\n>\t\"a\": [\n>\t\t1,\n>\t\t2\n>\t],\n>\t\"b\": {}\n>}"},
+		{in: `1`, want: `1`},
+		{in: `[1,`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := Indent(RawValue(tt.in), ">", "\t")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Indent() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Indent() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsCompact(t *testing.T) {
+	tests := []struct {
+		in   string
+		want bool
+	}{
+		{`{"a":[1,2]}`, true},
+		{`"a b\" c"`, true},
+		{`["\\"," "]`, true},
+		{`{"a": 1}`, false},
+		{` 1`, false},
+		{"[1,\n2]", false},
+		{`["\\" ,1]`, false},
+		{`{"a":1`, false},
+		{``, false},
+	}
+	for _, tt := range tests {
+		if got := IsCompact(RawValue(tt.in)); got != tt.want {
+			t.Errorf("IsCompact(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{in: `{"b": 1, "a": {"d": [3, 1.50], "c": null}}`, want: `{"a":{"c":null,"d":[3,1.50]},"b":1}`},
+		{in: `{"a": 1, "a": 2}`, want: `{"a":2}`},
+		{in: `"<é>"`, want: `"\u003cé\u003e"`},
+		{in: ` 1e2 `, want: `1e2`},
+		{in: `{"a":`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := Normalize(RawValue(tt.in))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Normalize() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Normalize() = %s, want %s", got, tt.want)
+			}
+			if !tt.wantErr && !IsCompact(got) {
+				t.Errorf("IsCompact(%s) = false", got)
+			}
+		})
+	}
+}
+
+func TestFromSorted(t *testing.T) {
+	var ordered OrderedObject
+	ordered.Set("z", 1)
+	ordered.Set("a", Object{"y": true, "b": nil})
+
+	tests := []struct {
+		name    string
+		in      any
+		want    string
+		wantErr bool
+	}{
+		{name: "map", in: map[string]int{"b": 1, "a": 2}, want: `{"a":2,"b":1}`},
+		{name: "struct", in: struct{ Z, A int }{1, 2}, want: `{"A":2,"Z":1}`},
+		{name: "ordered", in: &ordered, want: `{"a":{"b":null,"y":true},"z":1}`},
+		{name: "nested", in: Array{struct{ B, A RawValue }{RawValue(`{"d":1,"c":2}`), RawValue(`1.50`)}}, want: `[{"A":1.50,"B":{"c":2,"d":1}}]`},
+		{name: "func", in: func() {}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FromSorted(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FromSorted() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if string(got) != tt.want {
+				t.Errorf("FromSorted() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
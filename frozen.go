@@ -0,0 +1,138 @@
+package jason
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// FrozenObject is a read-only view of an Object.
+//
+// Nested objects and arrays are frozen as well.
+// It marshals to the same JSON as the Object it was created from.
+type FrozenObject struct {
+	m map[string]any
+}
+
+// FrozenArray is a read-only view of an Array.
+//
+// Nested objects and arrays are frozen as well.
+// It marshals to the same JSON as the Array it was created from.
+type FrozenArray struct {
+	a []any
+}
+
+// Freeze returns a read-only copy of a decoded JSON value, panics on error.
+//
+// Objects are converted to FrozenObject, arrays to FrozenArray,
+// and other values are returned as is.
+// The value must be made of the types accepted by Clone,
+// RawObject or RawArray; the latter are frozen like objects and arrays.
+// The result is deep copied from v, so later changes to v do not affect it,
+// and it is safe for concurrent use.
+//
+// RawValue leaves are copied as well, but are still byte slices:
+// Freeze can not stop the RawValues returned by Get and Index
+// from being modified, so callers must not modify them.
+//
+// Example of sharing a configuration between goroutines:
+//
+//	cfg := jason.Freeze(tree).(jason.FrozenObject)
+func Freeze(v any) any {
+	switch v := v.(type) {
+	case Object:
+		if v == nil {
+			return FrozenObject{}
+		}
+		m := make(map[string]any, len(v))
+		for k, e := range v {
+			m[k] = Freeze(e)
+		}
+		return FrozenObject{m}
+	case Array:
+		if v == nil {
+			return FrozenArray{}
+		}
+		a := make([]any, len(v))
+		for i, e := range v {
+			a[i] = Freeze(e)
+		}
+		return FrozenArray{a}
+	case RawObject:
+		if v == nil {
+			return FrozenObject{}
+		}
+		m := make(map[string]any, len(v))
+		for k, e := range v {
+			m[k] = RawValue(bytes.Clone(e))
+		}
+		return FrozenObject{m}
+	case RawArray:
+		if v == nil {
+			return FrozenArray{}
+		}
+		a := make([]any, len(v))
+		for i, e := range v {
+			a[i] = RawValue(bytes.Clone(e))
+		}
+		return FrozenArray{a}
+	case FrozenObject, FrozenArray:
+		return v
+	}
+	return Clone(v)
+}
+
+// IsFrozen reports whether v is deeply immutable:
+// a FrozenObject, a FrozenArray, or a scalar value.
+func IsFrozen(v any) bool {
+	switch v.(type) {
+	case FrozenObject, FrozenArray, Number, string, float64, bool, nil:
+		return true
+	}
+	return false
+}
+
+// Len returns the number of keys in o.
+func (o FrozenObject) Len() int {
+	return len(o.m)
+}
+
+// Keys returns the keys of o, in sorted order.
+func (o FrozenObject) Keys() []string {
+	keys := make([]string, 0, len(o.m))
+	for k := range o.m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Get returns the value of key, and whether it was found.
+func (o FrozenObject) Get(key string) (any, bool) {
+	v, ok := o.m[key]
+	return v, ok
+}
+
+// MarshalJSON implements [json.Marshaler].
+func (o FrozenObject) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.m)
+}
+
+// Len returns the number of elements in a.
+func (a FrozenArray) Len() int {
+	return len(a.a)
+}
+
+// Index returns the element at index i, panics if out of range.
+func (a FrozenArray) Index(i int) any {
+	if i < 0 || i >= len(a.a) {
+		panic(fmt.Sprintf("jason: index %d out of range (length %d)", i, len(a.a)))
+	}
+	return a.a[i]
+}
+
+// MarshalJSON implements [json.Marshaler].
+func (a FrozenArray) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.a)
+}
@@ -0,0 +1,95 @@
+package jason
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFreeze_raw(t *testing.T) {
+	raw := RawValue(`[1]`)
+	tests := []struct {
+		name string
+		in   any
+		get  func(any) any
+	}{
+		{"RawValue", Object{"a": raw}, func(v any) any { e, _ := v.(FrozenObject).Get("a"); return e }},
+		{"RawObject", RawObject{"a": raw}, func(v any) any { e, _ := v.(FrozenObject).Get("a"); return e }},
+		{"RawArray", RawArray{raw}, func(v any) any { return v.(FrozenArray).Index(0) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := Freeze(tt.in)
+			raw[1] = '2'
+			defer func() { raw[1] = '1' }()
+			if got := tt.get(f).(RawValue); string(got) != `[1]` {
+				t.Errorf("got %s, want [1]", got)
+			}
+		})
+	}
+}
+
+func TestFreeze(t *testing.T) {
+	tree := Object{"b": Array{Number("1"), Object{"c": true}}, "a": "s", "n": nil}
+	f := Freeze(tree).(FrozenObject)
+	tree["a"] = "changed"
+	tree["b"].(Array)[0] = "changed"
+
+	if got := string(From(f)); got != `{"a":"s","b":[1,{"c":true}],"n":null}` {
+		t.Errorf("From(Freeze()) = %s", got)
+	}
+	if f.Len() != 3 || !reflect.DeepEqual(f.Keys(), []string{"a", "b", "n"}) {
+		t.Errorf("Len() = %d, Keys() = %q", f.Len(), f.Keys())
+	}
+	if v, ok := f.Get("n"); !ok || v != nil {
+		t.Errorf("Get(n) = %v, %v, want nil, true", v, ok)
+	}
+	if _, ok := f.Get("x"); ok {
+		t.Error("Get(x) found a missing key")
+	}
+	b, _ := f.Get("b")
+	arr, ok := b.(FrozenArray)
+	if !ok || arr.Len() != 2 || arr.Index(0) != Number("1") {
+		t.Fatalf("Get(b) = %#v, want a FrozenArray", b)
+	}
+	if _, ok := arr.Index(1).(FrozenObject); !ok {
+		t.Errorf("Index(1) = %#v, want a FrozenObject", arr.Index(1))
+	}
+	same := func(a, b any) bool { return reflect.ValueOf(a).UnsafePointer() == reflect.ValueOf(b).UnsafePointer() }
+	if !same(Freeze(f).(FrozenObject).m, f.m) || !same(Freeze(arr).(FrozenArray).a, arr.a) {
+		t.Error("Freeze() copied a frozen value")
+	}
+	if got := string(From(Freeze(Object(nil)))); got != `null` {
+		t.Errorf("From(Freeze(nil)) = %s, want null", got)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Index() did not panic out of range")
+		}
+	}()
+	arr.Index(2)
+}
+
+func TestIsFrozen(t *testing.T) {
+	tests := []struct {
+		in   any
+		want bool
+	}{
+		{Freeze(Object{"a": Array{Number("1")}}), true},
+		{Freeze(Array{}), true},
+		{Number("1"), true},
+		{"s", true},
+		{1.5, true},
+		{false, true},
+		{nil, true},
+		{Object{}, false},
+		{Array{}, false},
+		{RawValue(`1`), false},
+		{1, false},
+	}
+	for _, tt := range tests {
+		if got := IsFrozen(tt.in); got != tt.want {
+			t.Errorf("IsFrozen(%#v) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
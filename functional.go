@@ -0,0 +1,110 @@
+package jason
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// Map unmarshals each element of arr into a value of type T,
+// applies fn to it, and marshals the result into a new RawArray.
+//
+// Example of extracting the names of users:
+//
+//	jason.Map(users, func(u User) string { return u.Name })
+func Map[T, U any](arr RawArray, fn func(T) U) (RawArray, error) {
+	res := make(RawArray, len(arr))
+	for i, e := range arr {
+		var v T
+		if err := json.Unmarshal(e, &v); err != nil {
+			return nil, fmt.Errorf("jason: index %d: %w", i, err)
+		}
+		j, err := json.Marshal(fn(v))
+		if err != nil {
+			return nil, fmt.Errorf("jason: index %d: %w", i, err)
+		}
+		res[i] = j
+	}
+	return res, nil
+}
+
+// Filter unmarshals each element of arr into a value of type T,
+// and returns the elements for which pred returns true.
+//
+// Example of selecting active users:
+//
+//	jason.Filter(users, func(u User) bool { return u.Active })
+func Filter[T any](arr RawArray, pred func(T) bool) (RawArray, error) {
+	var res RawArray
+	for i, e := range arr {
+		var v T
+		if err := json.Unmarshal(e, &v); err != nil {
+			return nil, fmt.Errorf("jason: index %d: %w", i, err)
+		}
+		if pred(v) {
+			res = append(res, e)
+		}
+	}
+	return res, nil
+}
+
+// Reduce unmarshals each element of arr into a value of type T,
+// and combines them with fn, starting from init.
+//
+// Example of adding up prices:
+//
+//	jason.Reduce(prices, 0.0, func(sum, p float64) float64 { return sum + p })
+func Reduce[T, A any](arr RawArray, init A, fn func(A, T) A) (A, error) {
+	acc := init
+	for i, e := range arr {
+		var v T
+		if err := json.Unmarshal(e, &v); err != nil {
+			return init, fmt.Errorf("jason: index %d: %w", i, err)
+		}
+		acc = fn(acc, v)
+	}
+	return acc, nil
+}
+
+// Stop is used as a return value from the function passed to
+// ForEach or ForEachObject to stop iterating without an error.
+var Stop = errors.New("stop iteration")
+
+// ForEach calls fn for each element of arr, in order.
+//
+// If fn returns Stop, ForEach stops and returns nil;
+// any other error stops ForEach, and is returned with the index of the element.
+//
+// Example of processing elements until one is invalid:
+//
+//	err := jason.ForEach(arr, func(i int, v jason.RawValue) error {
+//		return process(v)
+//	})
+func ForEach(arr RawArray, fn func(i int, v RawValue) error) error {
+	for i, v := range arr {
+		if err := fn(i, v); err != nil {
+			if err == Stop {
+				return nil
+			}
+			return fmt.Errorf("jason: index %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// ForEachObject calls fn for each key/value pair of o,
+// in the sorted order of the keys.
+//
+// Errors are handled as by ForEach,
+// and are returned with the key of the value.
+func ForEachObject(o RawObject, fn func(key string, v RawValue) error) error {
+	for _, k := range Keys(o) {
+		if err := fn(k, o[k]); err != nil {
+			if err == Stop {
+				return nil
+			}
+			return fmt.Errorf("jason: key %q: %w", k, err)
+		}
+	}
+	return nil
+}
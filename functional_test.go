@@ -0,0 +1,147 @@
+package jason
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type functionalUser struct {
+	Name   string `json:"name"`
+	Active bool   `json:"active"`
+}
+
+var functionalUsers = RawArray{
+	RawValue(`{"name":"Alice","active":true}`),
+	RawValue(`{"name":"Bob"}`),
+	RawValue(`{"name":"Carol","active":true}`),
+}
+
+func TestMap(t *testing.T) {
+	got, err := Map(functionalUsers, func(u functionalUser) string { return u.Name })
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := RawArray{RawValue(`"Alice"`), RawValue(`"Bob"`), RawValue(`"Carol"`)}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Map() = %s, want %s", got, want)
+	}
+
+	got, err = Map(RawArray{}, func(u functionalUser) string { return u.Name })
+	if err != nil || len(got) != 0 {
+		t.Errorf("Map() = %s, %v, want []", got, err)
+	}
+
+	_, err = Map(RawArray{RawValue(`1`), RawValue(`"x"`)}, func(n int) int { return n })
+	if err == nil || !strings.Contains(err.Error(), "index 1") {
+		t.Errorf("Map() error = %v, want index 1", err)
+	}
+	_, err = Map(RawArray{RawValue(`1`)}, func(n int) func() { return nil })
+	if err == nil || !strings.Contains(err.Error(), "index 0") {
+		t.Errorf("Map() error = %v, want index 0", err)
+	}
+}
+
+func TestFilter(t *testing.T) {
+	got, err := Filter(functionalUsers, func(u functionalUser) bool { return u.Active })
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := RawArray{functionalUsers[0], functionalUsers[2]}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Filter() = %s, want %s", got, want)
+	}
+
+	got, err = Filter(functionalUsers, func(u functionalUser) bool { return false })
+	if err != nil || len(got) != 0 {
+		t.Errorf("Filter() = %s, %v, want []", got, err)
+	}
+
+	_, err = Filter(functionalUsers, func(n int) bool { return true })
+	if err == nil || !strings.Contains(err.Error(), "index 0") {
+		t.Errorf("Filter() error = %v, want index 0", err)
+	}
+}
+
+func TestReduce(t *testing.T) {
+	prices := RawArray{RawValue(`1.5`), RawValue(`2`), RawValue(`0.25`)}
+	got, err := Reduce(prices, 0.0, func(sum, p float64) float64 { return sum + p })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != 3.75 {
+		t.Errorf("Reduce() = %v, want 3.75", got)
+	}
+
+	got, err = Reduce(nil, 10.0, func(sum, p float64) float64 { return sum + p })
+	if err != nil || got != 10 {
+		t.Errorf("Reduce() = %v, %v, want 10", got, err)
+	}
+
+	got, err = Reduce(append(prices, RawValue(`"x"`)), 10.0, func(sum, p float64) float64 { return sum + p })
+	if err == nil || !strings.Contains(err.Error(), "index 3") {
+		t.Errorf("Reduce() error = %v, want index 3", err)
+	}
+	if got != 10 {
+		t.Errorf("Reduce() = %v on error, want init", got)
+	}
+}
+
+func TestForEach(t *testing.T) {
+	arr := RawArray{RawValue(`1`), RawValue(`2`), RawValue(`3`)}
+
+	var seen []int
+	err := ForEach(arr, func(i int, v RawValue) error {
+		seen = append(seen, i)
+		if string(v) == `2` {
+			return Stop
+		}
+		return nil
+	})
+	if err != nil || !reflect.DeepEqual(seen, []int{0, 1}) {
+		t.Errorf("ForEach() = %v, visited %v, want nil, [0 1]", err, seen)
+	}
+
+	errFn := errors.New("fn failed")
+	err = ForEach(arr, func(i int, v RawValue) error {
+		if i == 2 {
+			return errFn
+		}
+		return nil
+	})
+	if !errors.Is(err, errFn) || !strings.Contains(err.Error(), "index 2") {
+		t.Errorf("ForEach() error = %v, want index 2", err)
+	}
+
+	if err := ForEach(nil, func(int, RawValue) error { return errFn }); err != nil {
+		t.Errorf("ForEach(nil) = %v, want nil", err)
+	}
+}
+
+func TestForEachObject(t *testing.T) {
+	obj := RawObject{"c": RawValue(`3`), "a": RawValue(`1`), "b": RawValue(`2`)}
+
+	var seen []string
+	err := ForEachObject(obj, func(k string, v RawValue) error {
+		seen = append(seen, k)
+		if k == "b" {
+			return Stop
+		}
+		return nil
+	})
+	if err != nil || !reflect.DeepEqual(seen, []string{"a", "b"}) {
+		t.Errorf("ForEachObject() = %v, visited %v, want nil, [a b]", err, seen)
+	}
+
+	errFn := errors.New("fn failed")
+	err = ForEachObject(obj, func(k string, v RawValue) error {
+		if string(v) == `3` {
+			return errFn
+		}
+		return nil
+	})
+	if !errors.Is(err, errFn) || !strings.Contains(err.Error(), `key "c"`) {
+		t.Errorf("ForEachObject() error = %v, want key \"c\"", err)
+	}
+}
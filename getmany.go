@@ -0,0 +1,142 @@
+package jason
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"strconv"
+)
+
+// GetMany resolves the JSON Pointers ptrs against j in a single pass,
+// and returns the values found, keyed by pointer.
+//
+// Pointers that do not resolve to a value are omitted.
+// If j repeats an object key, the first of its values is used.
+// Decoding stops as soon as every pointer is resolved,
+// so malformed input after that is not reported.
+//
+// Example of extracting a projection from a large payload:
+//
+//	vals, err := jason.GetMany(j, "/id", "/user/name", "/items/0/sku")
+func GetMany(j RawValue, ptrs ...string) (map[string]RawValue, error) {
+	root := &ptrTrie{}
+	for _, ptr := range ptrs {
+		tokens, err := SplitPointer(ptr)
+		if err != nil {
+			return nil, err
+		}
+		root.insert(tokens, ptr)
+	}
+
+	g := getMany{
+		data:    j,
+		dec:     json.NewDecoder(bytes.NewReader(j)),
+		results: map[string]RawValue{},
+		pending: root.count(),
+	}
+	switch err := g.value(root); err {
+	case errQueryDone:
+	case nil:
+		if _, err := g.dec.Token(); err != io.EOF {
+			if err == nil {
+				err = errTrailingData
+			}
+			return nil, err
+		}
+	default:
+		return nil, unexpectedEOF(err)
+	}
+	return g.results, nil
+}
+
+// ptrTrie is a trie of JSON Pointer tokens.
+// Leaves hold every pointer that unescapes to their tokens,
+// and are marked done once resolved.
+type ptrTrie struct {
+	ptrs     []string
+	done     bool
+	children map[string]*ptrTrie
+}
+
+func (t *ptrTrie) insert(tokens []string, ptr string) {
+	for _, tok := range tokens {
+		if t.children == nil {
+			t.children = map[string]*ptrTrie{}
+		}
+		c := t.children[tok]
+		if c == nil {
+			c = &ptrTrie{}
+			t.children[tok] = c
+		}
+		t = c
+	}
+	t.ptrs = append(t.ptrs, ptr)
+}
+
+func (t *ptrTrie) count() int {
+	n := 0
+	if len(t.ptrs) > 0 {
+		n++
+	}
+	for _, c := range t.children {
+		n += c.count()
+	}
+	return n
+}
+
+type getMany struct {
+	data    []byte
+	dec     *json.Decoder
+	results map[string]RawValue
+	pending int
+}
+
+func (g *getMany) value(t *ptrTrie) error {
+	start := g.dec.InputOffset()
+	tok, err := g.dec.Token()
+	if err != nil {
+		return err
+	}
+	switch tok {
+	case json.Delim('{'):
+		for g.dec.More() {
+			k, err := g.dec.Token()
+			if err != nil {
+				return err
+			}
+			if err := g.child(t.children[k.(string)]); err != nil {
+				return err
+			}
+		}
+		_, err = g.dec.Token()
+	case json.Delim('['):
+		for i := 0; g.dec.More(); i++ {
+			if err := g.child(t.children[strconv.Itoa(i)]); err != nil {
+				return err
+			}
+		}
+		_, err = g.dec.Token()
+	}
+	if err != nil {
+		return err
+	}
+
+	if len(t.ptrs) > 0 && !t.done {
+		t.done = true
+		raw := trimValue(g.data[start:g.dec.InputOffset()])
+		for _, ptr := range t.ptrs {
+			g.results[ptr] = append(RawValue(nil), raw...)
+		}
+		if g.pending--; g.pending == 0 {
+			return errQueryDone
+		}
+	}
+	return nil
+}
+
+func (g *getMany) child(t *ptrTrie) error {
+	if t == nil {
+		return skipValue(g.dec)
+	}
+	return g.value(t)
+}
@@ -0,0 +1,50 @@
+package jason
+
+import "testing"
+
+func TestGetMany(t *testing.T) {
+	doc := RawValue(`{"id": 7, "user": {"name": "Alice"}, "items": [{"sku": "a"}, {"sku": "b"}], "~": 1}`)
+	tests := []struct {
+		name    string
+		doc     RawValue
+		ptrs    []string
+		want    map[string]string
+		wantErr bool
+	}{
+		{name: "none", doc: doc, want: map[string]string{}},
+		{name: "root", doc: doc, ptrs: []string{""}, want: map[string]string{"": string(doc)}},
+		{name: "projection", doc: doc, ptrs: []string{"/id", "/user/name", "/items/1/sku"},
+			want: map[string]string{"/id": `7`, "/user/name": `"Alice"`, "/items/1/sku": `"b"`}},
+		{name: "nested", doc: doc, ptrs: []string{"/user", "/user/name"},
+			want: map[string]string{"/user": `{"name": "Alice"}`, "/user/name": `"Alice"`}},
+		{name: "missing", doc: doc, ptrs: []string{"/nope", "/items/5", "/id/x"}, want: map[string]string{}},
+		{name: "same tokens", doc: doc, ptrs: []string{"/~", "/~0"},
+			want: map[string]string{"/~": `1`, "/~0": `1`}},
+		{name: "repeated key", doc: RawValue(`{"a": 1, "a": 2, "b": 3}`), ptrs: []string{"/a", "/b"},
+			want: map[string]string{"/a": `1`, "/b": `3`}},
+		{name: "repeated parent", doc: RawValue(`{"a": {"x": 1}, "a": {"x": 2}, "b": 3}`), ptrs: []string{"/a/x", "/b"},
+			want: map[string]string{"/a/x": `1`, "/b": `3`}},
+		{name: "invalid pointer", doc: doc, ptrs: []string{"id"}, wantErr: true},
+		{name: "invalid json", doc: RawValue(`{"id": `), ptrs: []string{"/x"}, wantErr: true},
+		{name: "trailing data", doc: RawValue(`{} {}`), ptrs: []string{"/x"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GetMany(tt.doc, tt.ptrs...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetMany() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(got) != len(tt.want) {
+				t.Errorf("GetMany() = %s, want %v", got, tt.want)
+			}
+			for k, v := range tt.want {
+				if string(got[k]) != v {
+					t.Errorf("GetMany()[%q] = %s, want %s", k, got[k], v)
+				}
+			}
+		})
+	}
+}
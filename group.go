@@ -0,0 +1,78 @@
+package jason
+
+import "encoding/json"
+
+// FieldOption configures how functions operating on a field
+// of each element of an array, like GroupBy or SumBy,
+// handle elements lacking that field.
+type FieldOption uint
+
+const (
+	// SkipMissing skips elements where the field is missing.
+	SkipMissing FieldOption = 1 << iota
+	// SkipInvalid skips elements where the field has an unexpected type.
+	SkipInvalid
+)
+
+func fieldOptions(opts []FieldOption) (o FieldOption) {
+	for _, opt := range opts {
+		o |= opt
+	}
+	return o
+}
+
+// GroupBy buckets the elements of arr by the value
+// at the JSON Pointer ptr within each element.
+//
+// Each key of the resulting object is that value,
+// unquoted for strings, or as canonical JSON text otherwise.
+// Each value is an array of the elements sharing that key, in order.
+// Elements where ptr does not resolve to a value are grouped
+// under the empty key, unless SkipMissing is given.
+//
+// Example of grouping records by status:
+//
+//	jason.GroupBy(arr, "/status") // {"active":[...],"closed":[...]}
+func GroupBy(arr RawArray, ptr string, opts ...FieldOption) (RawObject, error) {
+	if _, err := SplitPointer(ptr); err != nil {
+		return nil, err
+	}
+	o := fieldOptions(opts)
+
+	groups := map[string]RawArray{}
+	for _, v := range arr {
+		var key string
+		val, err := Pointer(v, ptr)
+		switch {
+		case err == nil:
+			key, err = groupKey(val)
+			if err != nil {
+				return nil, err
+			}
+		case !isMissing(err):
+			return nil, err
+		case o&SkipMissing != 0:
+			continue
+		}
+		groups[key] = append(groups[key], v)
+	}
+
+	res := make(RawObject, len(groups))
+	for k, g := range groups {
+		var err error
+		if res[k], err = json.Marshal(g); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func groupKey(j RawValue) (string, error) {
+	if KindOf(j) == StringKind {
+		var s string
+		err := json.Unmarshal(j, &s)
+		return s, err
+	}
+	c, err := Canonical(j)
+	return string(c), err
+}
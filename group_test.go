@@ -0,0 +1,60 @@
+package jason
+
+import "testing"
+
+func TestGroupBy(t *testing.T) {
+	arr := RawArray{
+		RawValue(`{"id":1,"status":"active","tags":["a"]}`),
+		RawValue(`{"id":2,"status":"closed","tags":["a"]}`),
+		RawValue(`{"id":3}`),
+		RawValue(`{"id":4,"status":"active","tags":["b"]}`),
+		RawValue(`{"id":5,"status":1.0,"tags":{"y":1,"x":2}}`),
+		RawValue(`{"id":6,"status":null,"tags":{"x":2,"y":1}}`),
+	}
+	tests := []struct {
+		name string
+		ptr  string
+		opts []FieldOption
+		want string
+	}{
+		{"string", "/status", nil, `{
+			"active": [{"id":1,"status":"active","tags":["a"]}, {"id":4,"status":"active","tags":["b"]}],
+			"closed": [{"id":2,"status":"closed","tags":["a"]}],
+			"": [{"id":3}],
+			"1": [{"id":5,"status":1.0,"tags":{"y":1,"x":2}}],
+			"null": [{"id":6,"status":null,"tags":{"x":2,"y":1}}]
+		}`},
+		{"skip missing", "/status", []FieldOption{SkipMissing}, `{
+			"active": [{"id":1,"status":"active","tags":["a"]}, {"id":4,"status":"active","tags":["b"]}],
+			"closed": [{"id":2,"status":"closed","tags":["a"]}],
+			"1": [{"id":5,"status":1.0,"tags":{"y":1,"x":2}}],
+			"null": [{"id":6,"status":null,"tags":{"x":2,"y":1}}]
+		}`},
+		{"canonical", "/tags", []FieldOption{SkipMissing}, `{
+			"[\"a\"]": [{"id":1,"status":"active","tags":["a"]}, {"id":2,"status":"closed","tags":["a"]}],
+			"[\"b\"]": [{"id":4,"status":"active","tags":["b"]}],
+			"{\"x\":2,\"y\":1}": [{"id":5,"status":1.0,"tags":{"y":1,"x":2}}, {"id":6,"status":null,"tags":{"x":2,"y":1}}]
+		}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GroupBy(arr, tt.ptr, tt.opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !Equal(From(got), RawValue(tt.want)) {
+				t.Errorf("GroupBy() = %s, want %s", From(got), tt.want)
+			}
+		})
+	}
+
+	if got, err := GroupBy(nil, "/a"); err != nil || len(got) != 0 {
+		t.Errorf("GroupBy(nil) = %s, %v, want {}", got, err)
+	}
+	if _, err := GroupBy(arr, "status"); err == nil {
+		t.Error("GroupBy() accepted an invalid pointer")
+	}
+	if _, err := GroupBy(RawArray{RawValue(`{"a":[}`)}, "/a"); err == nil {
+		t.Error("GroupBy() accepted invalid JSON")
+	}
+}
@@ -0,0 +1,39 @@
+package jason
+
+import (
+	"crypto/sha256"
+	"hash"
+)
+
+// Hash returns the SHA-256 checksum of the canonical form of v.
+//
+// Documents that differ only in key order, whitespace,
+// or number spelling hash identically.
+//
+// Example of deduplicating records:
+//
+//	if h, err := jason.Hash(j); err == nil && !seen[h] { ... }
+func Hash(v any) ([32]byte, error) {
+	var sum [32]byte
+	h := sha256.New()
+	if err := HashTo(h, v); err != nil {
+		return sum, err
+	}
+	h.Sum(sum[:0])
+	return sum, nil
+}
+
+// HashTo writes the canonical form of v to h.
+//
+// Example of hashing a document with SHA-512:
+//
+//	h := sha512.New()
+//	if err := jason.HashTo(h, j); err == nil { h.Sum(nil) }
+func HashTo(h hash.Hash, v any) error {
+	b, err := Canonical(v)
+	if err != nil {
+		return err
+	}
+	h.Write(b)
+	return nil
+}
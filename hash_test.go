@@ -0,0 +1,44 @@
+package jason
+
+import (
+	"crypto/sha256"
+	"testing"
+)
+
+func TestHash(t *testing.T) {
+	want := sha256.Sum256([]byte(`{"a":[1,"x"],"b":null}`))
+	for _, in := range []any{
+		RawValue(`{"a":[1,"x"],"b":null}`),
+		RawValue(` { "b" : null, "a" : [ 1.0, "x" ] } `),
+		Object{"b": nil, "a": Array{Number("10e-1"), "x"}},
+	} {
+		got, err := Hash(in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Errorf("Hash(%v) = %x, want %x", in, got, want)
+		}
+	}
+
+	other, err := Hash(RawValue(`{"a":[1,"x"],"b":false}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if other == want {
+		t.Error("Hash() of different documents is the same")
+	}
+	if _, err := Hash(RawValue(`{`)); err == nil {
+		t.Error("Hash() of invalid JSON succeeded")
+	}
+}
+
+func TestHashTo(t *testing.T) {
+	h := sha256.New()
+	if err := HashTo(h, RawValue(`[ 1 ]`)); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := [32]byte(h.Sum(nil)), sha256.Sum256([]byte(`[1]`)); got != want {
+		t.Errorf("HashTo() = %x, want %x", got, want)
+	}
+}
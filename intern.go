@@ -0,0 +1,103 @@
+package jason
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+)
+
+// InternOption configures AsTreeInterned.
+type InternOption uint
+
+const (
+	// InternKeysOnly interns object keys, but not string values.
+	InternKeysOnly InternOption = 1 << iota
+)
+
+// AsTreeInterned decodes j into a tree, like AsTree,
+// but interning object keys and string values,
+// so that repeated strings share memory.
+//
+// Strings are interned per call, not globally.
+//
+// Example of loading many records with repeated keys:
+//
+//	tree, err := jason.AsTreeInterned(j, jason.InternKeysOnly)
+func AsTreeInterned(j RawValue, opts ...InternOption) (any, error) {
+	var o InternOption
+	for _, opt := range opts {
+		o |= opt
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(j))
+	dec.UseNumber()
+	in := interner{
+		strings: map[string]string{},
+		values:  o&InternKeysOnly == 0,
+	}
+	v, err := in.decode(dec)
+	if err != nil {
+		return nil, unexpectedEOF(err)
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		if err == nil {
+			err = errTrailingData
+		}
+		return nil, err
+	}
+	return v, nil
+}
+
+type interner struct {
+	strings map[string]string
+	values  bool
+}
+
+func (in *interner) intern(s string) string {
+	if i, ok := in.strings[s]; ok {
+		return i
+	}
+	in.strings[s] = s
+	return s
+}
+
+func (in *interner) decode(dec *json.Decoder) (any, error) {
+	t, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	switch t := t.(type) {
+	case json.Delim:
+		if t == '{' {
+			obj := Object{}
+			for dec.More() {
+				k, err := dec.Token()
+				if err != nil {
+					return nil, err
+				}
+				v, err := in.decode(dec)
+				if err != nil {
+					return nil, err
+				}
+				obj[in.intern(k.(string))] = v
+			}
+			_, err := dec.Token()
+			return obj, err
+		}
+		arr := Array{}
+		for dec.More() {
+			v, err := in.decode(dec)
+			if err != nil {
+				return nil, err
+			}
+			arr = append(arr, v)
+		}
+		_, err := dec.Token()
+		return arr, err
+	case string:
+		if in.values {
+			return in.intern(t), nil
+		}
+	}
+	return t, nil
+}
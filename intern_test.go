@@ -0,0 +1,49 @@
+package jason
+
+import (
+	"reflect"
+	"testing"
+	"unsafe"
+)
+
+func TestAsTreeInterned(t *testing.T) {
+	j := RawValue(`[{"name":"a","kind":"name"},{"name":"b","kind":"name"},1.50]`)
+	want, err := AsTree(j)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := AsTreeInterned(j)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("AsTreeInterned() = %v, want %v", got, want)
+	}
+	arr := got.(Array)
+	kind0 := arr[0].(Object)["kind"].(string)
+	kind1 := arr[1].(Object)["kind"].(string)
+	if unsafe.StringData(kind0) != unsafe.StringData(kind1) {
+		t.Error("AsTreeInterned() did not intern repeated values")
+	}
+
+	got, err = AsTreeInterned(j, InternKeysOnly)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("AsTreeInterned() = %v, want %v", got, want)
+	}
+	arr = got.(Array)
+	kind0 = arr[0].(Object)["kind"].(string)
+	kind1 = arr[1].(Object)["kind"].(string)
+	if unsafe.StringData(kind0) == unsafe.StringData(kind1) {
+		t.Error("AsTreeInterned() interned values with InternKeysOnly")
+	}
+
+	for _, in := range []string{``, `[1,`, `{"a":1} 2`, `{"a"}`} {
+		if _, err := AsTreeInterned(RawValue(in)); err == nil {
+			t.Errorf("AsTreeInterned(%q) accepted invalid JSON", in)
+		}
+	}
+}
@@ -1,7 +1,11 @@
 // Package jason helps deal with dynamic JSON.
 package jason
 
-import "encoding/json"
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
 
 // Object is a JSON object:
 // an unordered set of name/value pairs.
@@ -40,13 +44,38 @@ type RawValue = json.RawMessage
 // Example of creating a RawValue from a time instant:
 //   jason.From(time.Now())
 func From(v any) RawValue {
-	b, err := json.Marshal(v)
+	b, err := TryFrom(v)
 	if err != nil {
 		panic(err)
 	}
 	return b
 }
 
+// TryFrom marshals v into a RawValue.
+//
+// Example of creating a RawValue from a user supplied value:
+//   if j, err := jason.TryFrom(v); err == nil { ... }
+func TryFrom(v any) (RawValue, error) {
+	return json.Marshal(v)
+}
+
+// FromUnescaped marshals v into a RawValue, like TryFrom,
+// but without escaping <, > and & in strings.
+//
+// The result is not safe to embed directly in HTML.
+//
+// Example of creating a RawValue for display in a terminal:
+//   if j, err := jason.FromUnescaped(v); err == nil { ... }
+func FromUnescaped(v any) (RawValue, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
 // ToA unmarshals j into a value of type T, panics on error.
 //
 // Example of converting j into a time instant:
@@ -77,6 +106,34 @@ func IsA[T any](j RawValue) bool {
 	return err == nil
 }
 
+// Safe calls ToA, converting any panic into an error.
+//
+// Example of calling ToA at an API boundary:
+//   if v, err := jason.Safe[time.Time](j); err == nil { ... }
+func Safe[T any](j RawValue) (v T, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if e, ok := r.(error); ok {
+				err = e
+			} else {
+				err = fmt.Errorf("jason: %v", r)
+			}
+		}
+	}()
+	return ToA[T](j), nil
+}
+
+// Must returns v, panics if err is not nil.
+//
+// Example of converting j into a time instant, with AsA:
+//   jason.Must(jason.AsA[time.Time](j))
+func Must[T any](v T, err error) T {
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // RawObject is an object of RawValue's.
 type RawObject = map[string]RawValue
 
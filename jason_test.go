@@ -0,0 +1,93 @@
+package jason
+
+import "testing"
+
+func TestTryFrom(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      any
+		want    string
+		wantErr bool
+	}{
+		{name: "nil", in: nil, want: `null`},
+		{name: "struct", in: struct{ A int }{1}, want: `{"A":1}`},
+		{name: "html", in: "<&>", want: `"\u003c\u0026\u003e"`},
+		{name: "chan", in: make(chan int), wantErr: true},
+		{name: "func", in: Object{"f": func() {}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := TryFrom(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("TryFrom() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if string(got) != tt.want {
+				t.Errorf("TryFrom() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFrom_panics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("From() did not panic")
+		}
+	}()
+	From(make(chan int))
+}
+
+func TestFromUnescaped(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      any
+		want    string
+		wantErr bool
+	}{
+		{name: "nil", in: nil, want: `null`},
+		{name: "html", in: "<&>", want: `"<&>"`},
+		{name: "nested", in: Object{"a<b": Array{"x&y"}}, want: `{"a<b":["x&y"]}`},
+		{name: "newline", in: "a\nb", want: `"a\nb"`},
+		{name: "chan", in: make(chan int), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FromUnescaped(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FromUnescaped() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if string(got) != tt.want {
+				t.Errorf("FromUnescaped() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+type panicking struct{}
+
+func (*panicking) UnmarshalJSON([]byte) error { panic("boom") }
+
+func TestSafe(t *testing.T) {
+	if v, err := Safe[int](RawValue(`42`)); err != nil || v != 42 {
+		t.Errorf("Safe() = %v, %v, want 42", v, err)
+	}
+	if _, err := Safe[int](RawValue(`"x"`)); err == nil {
+		t.Error("Safe() did not return the error")
+	}
+	_, err := Safe[panicking](RawValue(`{}`))
+	if err == nil || err.Error() != "jason: boom" {
+		t.Errorf("Safe() error = %v, want jason: boom", err)
+	}
+}
+
+func TestMust(t *testing.T) {
+	if v := Must(AsA[int](RawValue(`42`))); v != 42 {
+		t.Errorf("Must() = %v, want 42", v)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("Must() did not panic")
+		}
+	}()
+	Must(AsA[int](RawValue(`"x"`)))
+}
@@ -0,0 +1,45 @@
+// Package jasontest provides helpers for tests that compare JSON.
+package jasontest
+
+import (
+	"testing"
+
+	"github.com/ncruces/jason"
+)
+
+// RequireEqual fails the test, and stops it,
+// unless want and got are equal, as by [jason.Equal].
+//
+// The failure message lists the differences, as by [jason.DiffText].
+//
+// Example of checking an API response:
+//
+//	jasontest.RequireEqual(t, jason.RawValue(`{"id":1}`), body)
+func RequireEqual(t testing.TB, want, got jason.RawValue) {
+	t.Helper()
+	if jason.Equal(want, got) {
+		return
+	}
+	diff, err := jason.DiffText(want, got)
+	if err != nil {
+		t.Fatalf("jasontest: cannot compare documents: %v\nwant: %s\ngot:  %s", err, want, got)
+	}
+	t.Fatalf("jasontest: documents differ (- want, + got):\n%s", diff)
+}
+
+// RequireMatches fails the test, and stops it,
+// unless pattern matches at least one value in doc, as by [jason.Query].
+//
+// Example of checking that some user has an email:
+//
+//	jasontest.RequireMatches(t, body, "/users/*/email")
+func RequireMatches(t testing.TB, doc jason.RawValue, pattern string) {
+	t.Helper()
+	_, ok, err := jason.QueryOne(doc, pattern)
+	if err != nil {
+		t.Fatalf("jasontest: cannot query %q: %v", pattern, err)
+	}
+	if !ok {
+		t.Fatalf("jasontest: no value matches %q in:\n%s", pattern, doc)
+	}
+}
@@ -0,0 +1,76 @@
+package jasontest
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/ncruces/jason"
+)
+
+// fakeTB records a failure, and stops the goroutine, like [testing.T].
+type fakeTB struct {
+	testing.TB
+	failed bool
+	msg    string
+}
+
+func (t *fakeTB) Helper() {}
+
+func (t *fakeTB) Fatalf(format string, args ...any) {
+	t.failed = true
+	t.msg = fmt.Sprintf(format, args...)
+	runtime.Goexit()
+}
+
+func run(fn func(t testing.TB)) *fakeTB {
+	t := &fakeTB{}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		fn(t)
+	}()
+	<-done
+	return t
+}
+
+func TestRequireEqual(t *testing.T) {
+	tests := []struct {
+		name      string
+		want, got string
+		fail      string
+	}{
+		{"equal", `{"a":1,"b":[true]}`, `{ "b": [true], "a": 1.0 }`, ""},
+		{"diff", `{"a":1,"b":[true]}`, `{"a":2,"b":[]}`, "~ /a: 1 -> 2"},
+		{"invalid", `{"a":1}`, `{`, "cannot compare"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ft := run(func(t testing.TB) {
+				RequireEqual(t, jason.RawValue(tt.want), jason.RawValue(tt.got))
+			})
+			if ft.failed != (tt.fail != "") || !strings.Contains(ft.msg, tt.fail) {
+				t.Errorf("failed: %v %q, want %q", ft.failed, ft.msg, tt.fail)
+			}
+		})
+	}
+}
+
+func TestRequireMatches(t *testing.T) {
+	doc := jason.RawValue(`{"users":[{"name":"a"},{"name":"b","email":"b@example.com"}]}`)
+	tests := []struct {
+		pattern string
+		fail    bool
+	}{
+		{"/users/*/email", false},
+		{"/users/0/email", true},
+		{"/groups", true},
+	}
+	for _, tt := range tests {
+		ft := run(func(t testing.TB) { RequireMatches(t, doc, tt.pattern) })
+		if ft.failed != tt.fail {
+			t.Errorf("RequireMatches(%q) failed: %v, want %v", tt.pattern, ft.failed, tt.fail)
+		}
+	}
+}
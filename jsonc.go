@@ -0,0 +1,91 @@
+package jason
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// FromJSONC converts JSON with comments (JSONC) into standard JSON.
+//
+// Line (//) and block (/* */) comments, and trailing commas
+// in objects and arrays, are replaced with whitespace,
+// so byte offsets are preserved.
+// The result is then validated as JSON,
+// and errors report the line and column in b.
+//
+// Example of loading a commented config file:
+//
+//	b, err := os.ReadFile("config.jsonc")
+//	j, err := jason.FromJSONC(b)
+func FromJSONC(b []byte) (RawValue, error) {
+	j := make(RawValue, len(b))
+	copy(j, b)
+
+	for i := 0; i < len(j); i++ {
+		switch j[i] {
+		case '"':
+			for i++; i < len(j) && j[i] != '"'; i++ {
+				if j[i] == '\\' {
+					i++
+				}
+			}
+
+		case '/':
+			if i+1 >= len(j) {
+				break
+			}
+			switch j[i+1] {
+			case '/':
+				for ; i < len(j) && j[i] != '\n'; i++ {
+					j[i] = ' '
+				}
+			case '*':
+				end := bytes.Index(j[i+2:], []byte("*/"))
+				if end < 0 {
+					return nil, jsoncError(b, i, errors.New("unterminated comment"))
+				}
+				end += i + 4
+				for ; i < end; i++ {
+					if j[i] != '\n' {
+						j[i] = ' '
+					}
+				}
+				i--
+			}
+
+		case '}', ']':
+			// Only a comma that follows a value is trailing.
+			k := len(bytes.TrimRight(j[:i], " \t\r\n")) - 1
+			if k < 0 || j[k] != ',' {
+				break
+			}
+			if p := len(bytes.TrimRight(j[:k], " \t\r\n")) - 1; p >= 0 {
+				switch j[p] {
+				case '[', '{', ',', ':':
+				default:
+					j[k] = ' '
+				}
+			}
+		}
+	}
+
+	if err := Validate(j); err != nil {
+		var serr *json.SyntaxError
+		if errors.As(err, &serr) && serr.Offset > 0 {
+			return nil, jsoncError(b, int(serr.Offset-1), err)
+		}
+		return nil, err
+	}
+	return j, nil
+}
+
+func jsoncError(b []byte, offset int, err error) error {
+	if offset > len(b) {
+		offset = len(b)
+	}
+	line := 1 + bytes.Count(b[:offset], []byte("\n"))
+	col := 1 + offset - (bytes.LastIndexByte(b[:offset], '\n') + 1)
+	return fmt.Errorf("jason: line %d, column %d: %w", line, col, err)
+}
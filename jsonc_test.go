@@ -0,0 +1,44 @@
+package jason
+
+import "testing"
+
+func TestFromJSONC(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{`{"a":1} // comment`, `{"a":1}`},
+		{"[1, /* two */ 2]", `[1,2]`},
+		{`[1,2,]`, `[1,2]`},
+		{"{\"a\":[1,],\n}", `{"a":[1]}`},
+		{`["a,]", /* , */ ]`, `["a,]"]`},
+		{`[[],{},]`, `[[],{}]`},
+	}
+	for _, tt := range tests {
+		got, err := FromJSONC([]byte(tt.in))
+		if err != nil {
+			t.Errorf("FromJSONC(%q): %v", tt.in, err)
+			continue
+		}
+		if len(got) != len(tt.in) {
+			t.Errorf("FromJSONC(%q) = %q, offsets not preserved", tt.in, got)
+		}
+		if c, _ := Compact(got); string(c) != tt.want {
+			t.Errorf("FromJSONC(%q) = %q, want %s", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestFromJSONC_invalid(t *testing.T) {
+	for _, in := range []string{
+		`[,]`,
+		`{,}`,
+		`[1,,]`,
+		`{"a":,}`,
+		`[ /* x */ , ]`,
+		`[1 /* x`,
+	} {
+		if got, err := FromJSONC([]byte(in)); err == nil {
+			t.Errorf("FromJSONC(%q) = %q, want error", in, got)
+		}
+	}
+}
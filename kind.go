@@ -0,0 +1,82 @@
+package jason
+
+import "encoding/json"
+
+// Kind is the kind of JSON value a RawValue holds.
+type Kind uint8
+
+const (
+	// InvalidKind is the kind of input that is not valid JSON.
+	InvalidKind Kind = iota
+	// NullKind is the kind of null.
+	NullKind
+	// BoolKind is the kind of true and false.
+	BoolKind
+	// NumberKind is the kind of numbers.
+	NumberKind
+	// StringKind is the kind of strings.
+	StringKind
+	// ArrayKind is the kind of arrays.
+	ArrayKind
+	// ObjectKind is the kind of objects.
+	ObjectKind
+)
+
+// KindOf returns the kind of JSON value j holds,
+// or InvalidKind if j is not valid JSON.
+//
+// Example of branching on the kind of a value:
+//
+//	switch jason.KindOf(j) {
+//	case jason.ArrayKind: ...
+//	case jason.ObjectKind: ...
+//	}
+func KindOf(j RawValue) Kind {
+	if !json.Valid(j) {
+		return InvalidKind
+	}
+	return kindOf(j)
+}
+
+// kindOf is like KindOf, but does not validate j:
+// it classifies j by its first non-whitespace byte.
+func kindOf(j RawValue) Kind {
+	for _, c := range j {
+		switch c {
+		case ' ', '\t', '\r', '\n':
+			continue
+		case 'n':
+			return NullKind
+		case 't', 'f':
+			return BoolKind
+		case '"':
+			return StringKind
+		case '[':
+			return ArrayKind
+		case '{':
+			return ObjectKind
+		case '-', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+			return NumberKind
+		}
+		return InvalidKind
+	}
+	return InvalidKind
+}
+
+func (k Kind) String() string {
+	switch k {
+	case NullKind:
+		return "null"
+	case BoolKind:
+		return "boolean"
+	case NumberKind:
+		return "number"
+	case StringKind:
+		return "string"
+	case ArrayKind:
+		return "array"
+	case ObjectKind:
+		return "object"
+	}
+	return "invalid"
+}
@@ -0,0 +1,34 @@
+package jason
+
+import "testing"
+
+func TestKindOf(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Kind
+	}{
+		{``, InvalidKind},
+		{`  `, InvalidKind},
+		{`x`, InvalidKind},
+		{` null`, NullKind},
+		{`true`, BoolKind},
+		{"\n-1", NumberKind},
+		{`"s"`, StringKind},
+		{`[1,`, InvalidKind},
+		{`[1]`, ArrayKind},
+		{`"s`, InvalidKind},
+		{`1 2`, InvalidKind},
+		{`{}`, ObjectKind},
+	}
+	for _, tt := range tests {
+		if got := KindOf(RawValue(tt.in)); got != tt.want {
+			t.Errorf("KindOf(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestByBool_invalid(t *testing.T) {
+	if ByBool(RawValue(`tru`), RawValue(`true`)) {
+		t.Error("invalid input sorted before a boolean")
+	}
+}
@@ -0,0 +1,99 @@
+package jason
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// LazyObject wraps a RawObject, decoding its values on first access,
+// and caching the results for later accesses.
+//
+// Values are accessed through LazyField.
+// A LazyObject is safe for concurrent use,
+// but the wrapped RawObject must not be modified while in use.
+type LazyObject struct {
+	raw   RawObject
+	mu    sync.RWMutex
+	cache map[lazyKey]lazyValue
+}
+
+type lazyKey struct {
+	key string
+	typ reflect.Type
+}
+
+type lazyValue struct {
+	val any
+	err error
+}
+
+// NewLazyObject returns a LazyObject that wraps o.
+//
+// Example of reading fields of a large object, from many goroutines:
+//
+//	lo := jason.NewLazyObject(o)
+//	name, err := jason.LazyField[string](lo, "name")
+func NewLazyObject(o RawObject) *LazyObject {
+	return &LazyObject{raw: o}
+}
+
+// Raw returns the wrapped RawObject.
+func (lo *LazyObject) Raw() RawObject {
+	return lo.raw
+}
+
+// Invalidate discards the cached values for key,
+// so they are decoded again on their next access.
+func (lo *LazyObject) Invalidate(key string) {
+	lo.mu.Lock()
+	defer lo.mu.Unlock()
+	for k := range lo.cache {
+		if k.key == key {
+			delete(lo.cache, k)
+		}
+	}
+}
+
+// Reset discards all cached values.
+func (lo *LazyObject) Reset() {
+	lo.mu.Lock()
+	defer lo.mu.Unlock()
+	lo.cache = nil
+}
+
+// LazyField returns the value for key in lo, unmarshaled into type T.
+//
+// The result, or error, is cached per key and type,
+// so a value that was decoded before is returned as is:
+// callers sharing lo should not modify it.
+// A missing key is reported as [ErrNotFound].
+func LazyField[T any](lo *LazyObject, key string) (T, error) {
+	k := lazyKey{key, reflect.TypeFor[T]()}
+
+	lo.mu.RLock()
+	c, ok := lo.cache[k]
+	lo.mu.RUnlock()
+
+	if !ok {
+		var v T
+		if j, found := lo.raw[key]; !found {
+			c.err = fmt.Errorf("jason: key %q: %w", key, ErrNotFound)
+		} else if err := json.Unmarshal(j, &v); err != nil {
+			c.err = fmt.Errorf("jason: key %q: %w", key, err)
+		} else {
+			c.val = v
+		}
+
+		lo.mu.Lock()
+		if lo.cache == nil {
+			lo.cache = map[lazyKey]lazyValue{}
+		}
+		lo.cache[k] = c
+		lo.mu.Unlock()
+	}
+
+	v, _ := c.val.(T)
+	return v, c.err
+}
@@ -0,0 +1,81 @@
+package jason
+
+import (
+	"encoding/json"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+var lazyDecodes atomic.Int32
+
+type lazyCounter struct{ n int }
+
+func (c *lazyCounter) UnmarshalJSON(b []byte) error {
+	lazyDecodes.Add(1)
+	return json.Unmarshal(b, &c.n)
+}
+
+func TestLazyField(t *testing.T) {
+	lo := NewLazyObject(RawObject{
+		"n": RawValue(`42`),
+		"s": RawValue(`"x"`),
+	})
+
+	if v, err := LazyField[int](lo, "n"); err != nil || v != 42 {
+		t.Errorf("LazyField[int]() = %v, %v, want 42", v, err)
+	}
+	if v, err := LazyField[float64](lo, "n"); err != nil || v != 42 {
+		t.Errorf("LazyField[float64]() = %v, %v, want 42", v, err)
+	}
+	if _, err := LazyField[int](lo, "s"); err == nil {
+		t.Error("LazyField[int]() accepted a string")
+	}
+	if _, err := LazyField[int](lo, "missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("LazyField() error = %v, want ErrNotFound", err)
+	}
+	if len(lo.Raw()) != 2 {
+		t.Errorf("Raw() = %v", lo.Raw())
+	}
+}
+
+func TestLazyField_cache(t *testing.T) {
+	lazyDecodes.Store(0)
+	lo := NewLazyObject(RawObject{"a": RawValue(`1`), "b": RawValue(`2`)})
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if v, err := LazyField[lazyCounter](lo, "a"); err != nil || v.n != 1 {
+				t.Errorf("LazyField() = %v, %v, want 1", v, err)
+			}
+		}()
+	}
+	wg.Wait()
+	LazyField[lazyCounter](lo, "a")
+	before := lazyDecodes.Load()
+	LazyField[lazyCounter](lo, "a")
+	if n := lazyDecodes.Load(); n != before {
+		t.Errorf("LazyField() decoded a cached value again")
+	}
+
+	LazyField[lazyCounter](lo, "b")
+	lo.Invalidate("a")
+	before = lazyDecodes.Load()
+	LazyField[lazyCounter](lo, "a")
+	LazyField[lazyCounter](lo, "b")
+	if n := lazyDecodes.Load(); n != before+1 {
+		t.Errorf("Invalidate() caused %d decodes, want 1", n-before)
+	}
+
+	lo.Reset()
+	before = lazyDecodes.Load()
+	LazyField[lazyCounter](lo, "a")
+	LazyField[lazyCounter](lo, "b")
+	if n := lazyDecodes.Load(); n != before+2 {
+		t.Errorf("Reset() caused %d decodes, want 2", n-before)
+	}
+}
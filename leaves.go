@@ -0,0 +1,105 @@
+package jason
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"strconv"
+)
+
+// Leaf is a scalar value in a document, and its JSON Pointer path.
+type Leaf struct {
+	Path  string
+	Value RawValue
+}
+
+// LeafOption configures Leaves.
+type LeafOption uint
+
+const (
+	// IncludeEmpty reports empty objects and arrays as leaves.
+	IncludeEmpty LeafOption = 1 << iota
+)
+
+// Leaves returns every scalar value in j, with its path, in document order.
+//
+// Unlike Flatten, values are returned as written in j,
+// and paths are JSON Pointers.
+// Empty objects and arrays are skipped, unless IncludeEmpty is given.
+//
+// Example of indexing a document into a search engine:
+//
+//	leaves, err := jason.Leaves(j)
+//	for _, l := range leaves { index.Add(l.Path, l.Value) }
+func Leaves(j RawValue, opts ...LeafOption) ([]Leaf, error) {
+	var o LeafOption
+	for _, opt := range opts {
+		o |= opt
+	}
+
+	l := leaves{data: j, dec: json.NewDecoder(bytes.NewReader(j)), empty: o&IncludeEmpty != 0}
+	if err := l.value(nil); err != nil {
+		return nil, unexpectedEOF(err)
+	}
+	if _, err := l.dec.Token(); err != io.EOF {
+		if err == nil {
+			err = errTrailingData
+		}
+		return nil, err
+	}
+	return l.leaves, nil
+}
+
+type leaves struct {
+	data   []byte
+	dec    *json.Decoder
+	empty  bool
+	leaves []Leaf
+}
+
+func (l *leaves) value(path []string) error {
+	start := l.dec.InputOffset()
+	t, err := l.dec.Token()
+	if err != nil {
+		return err
+	}
+
+	n := 0
+	switch t {
+	case json.Delim('{'):
+		for ; l.dec.More(); n++ {
+			k, err := l.dec.Token()
+			if err != nil {
+				return err
+			}
+			if err := l.value(append(path, k.(string))); err != nil {
+				return err
+			}
+		}
+	case json.Delim('['):
+		for ; l.dec.More(); n++ {
+			if err := l.value(append(path, strconv.Itoa(n))); err != nil {
+				return err
+			}
+		}
+	default:
+		l.add(path, start)
+		return nil
+	}
+
+	if _, err := l.dec.Token(); err != nil {
+		return err
+	}
+	if n == 0 && l.empty {
+		l.add(path, start)
+	}
+	return nil
+}
+
+func (l *leaves) add(path []string, start int64) {
+	raw := trimValue(l.data[start:l.dec.InputOffset()])
+	l.leaves = append(l.leaves, Leaf{
+		Path:  JoinPointer(path...),
+		Value: append(RawValue(nil), raw...),
+	})
+}
@@ -0,0 +1,51 @@
+package jason
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLeaves(t *testing.T) {
+	j := RawValue(`{"a": [1, {"b": null}, []], "c": {}, "d/e": "s", "f": 1.50e1}`)
+	tests := []struct {
+		name string
+		in   RawValue
+		opts []LeafOption
+		want []Leaf
+	}{
+		{"document", j, nil, []Leaf{
+			{"/a/0", RawValue(`1`)},
+			{"/a/1/b", RawValue(`null`)},
+			{"/d~1e", RawValue(`"s"`)},
+			{"/f", RawValue(`1.50e1`)},
+		}},
+		{"include empty", j, []LeafOption{IncludeEmpty}, []Leaf{
+			{"/a/0", RawValue(`1`)},
+			{"/a/1/b", RawValue(`null`)},
+			{"/a/2", RawValue(`[]`)},
+			{"/c", RawValue(`{}`)},
+			{"/d~1e", RawValue(`"s"`)},
+			{"/f", RawValue(`1.50e1`)},
+		}},
+		{"scalar", RawValue(` true `), nil, []Leaf{{"", RawValue(`true`)}}},
+		{"empty", RawValue(`[]`), nil, nil},
+		{"empty root", RawValue(`{ }`), []LeafOption{IncludeEmpty}, []Leaf{{"", RawValue(`{ }`)}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Leaves(tt.in, tt.opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Leaves() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	for _, in := range []string{``, `[1,`, `{"a":1} 2`, `{"a" 1}`} {
+		if _, err := Leaves(RawValue(in)); err == nil {
+			t.Errorf("Leaves(%q) accepted invalid JSON", in)
+		}
+	}
+}
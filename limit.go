@@ -0,0 +1,234 @@
+package jason
+
+import (
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+var (
+	// ErrTooLarge is returned when a value exceeds a byte budget.
+	ErrTooLarge = errors.New("value too large")
+	// ErrTooDeep is returned when a value exceeds a depth budget.
+	ErrTooDeep = errors.New("value too deep")
+)
+
+// LimitOption configures AsALimited.
+type LimitOption interface {
+	apply(*limits)
+}
+
+type limits struct {
+	depth int
+}
+
+type maxDepth int
+
+func (d maxDepth) apply(l *limits) { l.depth = int(d) }
+
+// MaxDepth returns a LimitOption that rejects values
+// nested more than n levels deep, as measured by Depth.
+func MaxDepth(n int) LimitOption {
+	return maxDepth(n)
+}
+
+// AsALimited unmarshals j into a value of type T, like AsA,
+// but first rejects values longer than maxBytes with ErrTooLarge,
+// and values exceeding a MaxDepth option with ErrTooDeep.
+//
+// The depth check tokenizes j, and stops as soon as the limit is exceeded.
+//
+// Example of decoding an untrusted request body:
+//
+//	v, err := jason.AsALimited[T](body, 1<<20, jason.MaxDepth(32))
+//	if errors.Is(err, jason.ErrTooLarge) { ... }
+func AsALimited[T any](j RawValue, maxBytes int, opts ...LimitOption) (v T, err error) {
+	l := limits{depth: -1}
+	for _, o := range opts {
+		o.apply(&l)
+	}
+
+	if err := checkLimits(j, maxBytes, l.depth); err != nil {
+		return v, err
+	}
+	err = json.Unmarshal(j, &v)
+	return v, err
+}
+
+// checkLimits checks j against the byte and depth limits,
+// each of which is disabled if negative.
+func checkLimits(j RawValue, maxBytes, maxDepth int) error {
+	if maxBytes >= 0 && len(j) > maxBytes {
+		return fmt.Errorf("jason: %w (%d bytes, limit %d)", ErrTooLarge, len(j), maxBytes)
+	}
+	if maxDepth >= 0 {
+		var st Stats
+		if err := scanStats(j, &st, maxDepth); err != nil {
+			return err
+		}
+		if st.Depth > maxDepth {
+			return fmt.Errorf("jason: %w (limit %d)", ErrTooDeep, maxDepth)
+		}
+	}
+	return nil
+}
+
+// FromCapped marshals v into a RawValue, like TryFrom,
+// but fails with ErrTooLarge as soon as the result
+// would be longer than maxBytes, unless maxBytes is negative.
+//
+// Slices, arrays, maps with string keys, and the values pointers point to,
+// are encoded one element at a time, into a buffer that never grows past maxBytes.
+// Other values, such as structs and types that implement [json.Marshaler],
+// are marshaled whole before being checked,
+// so a limit on a slice of structs bounds memory by the size of the limit,
+// plus the size of the largest struct.
+// The output is the same as for TryFrom.
+//
+// Example of enforcing a response size limit:
+//
+//	j, err := jason.FromCapped(resp, 1<<20)
+//	if errors.Is(err, jason.ErrTooLarge) { ... }
+func FromCapped(v any, maxBytes int) (RawValue, error) {
+	w := cappedWriter{max: maxBytes}
+	if err := w.value(reflect.ValueOf(v), 0); err != nil {
+		return nil, err
+	}
+	return w.buf, nil
+}
+
+// cappedWriter accumulates writes, failing past max bytes.
+type cappedWriter struct {
+	buf []byte
+	max int
+}
+
+func (w *cappedWriter) Write(p []byte) (int, error) {
+	if w.max >= 0 && len(w.buf)+len(p) > w.max {
+		return 0, fmt.Errorf("jason: %w (limit %d)", ErrTooLarge, w.max)
+	}
+	w.buf = append(w.buf, p...)
+	return len(p), nil
+}
+
+var (
+	marshalerType     = reflect.TypeFor[json.Marshaler]()
+	textMarshalerType = reflect.TypeFor[encoding.TextMarshaler]()
+)
+
+// value writes rv, nested depth levels deep.
+// Past a depth at which rv is likely to be cyclic,
+// it is marshaled whole, for json.Marshal to report the cycle.
+func (w *cappedWriter) value(rv reflect.Value, depth int) error {
+	if rv.Kind() == reflect.Interface {
+		rv = rv.Elem()
+	}
+	if depth < 1000 && rv.IsValid() && !isMarshaler(rv) {
+		switch rv.Kind() {
+		case reflect.Pointer:
+			if !rv.IsNil() {
+				return w.value(rv.Elem(), depth+1)
+			}
+		case reflect.Slice:
+			if rv.Type().Elem().Kind() == reflect.Uint8 {
+				break // []byte is base64 encoded
+			}
+			if !rv.IsNil() {
+				return w.array(rv, depth)
+			}
+		case reflect.Array:
+			return w.array(rv, depth)
+		case reflect.Map:
+			if rv.Type().Key().Kind() == reflect.String && !rv.IsNil() {
+				return w.object(rv, depth)
+			}
+		}
+	}
+
+	j, err := json.Marshal(marshaled(rv))
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(j)
+	return err
+}
+
+func (w *cappedWriter) object(rv reflect.Value, depth int) error {
+	keys := make([]string, 0, rv.Len())
+	for _, k := range rv.MapKeys() {
+		keys = append(keys, k.String())
+	}
+	sort.Strings(keys)
+
+	if _, err := w.Write([]byte{'{'}); err != nil {
+		return err
+	}
+	for i, k := range keys {
+		if i > 0 {
+			if _, err := w.Write([]byte{','}); err != nil {
+				return err
+			}
+		}
+		if err := w.value(reflect.ValueOf(k), depth+1); err != nil {
+			return err
+		}
+		if _, err := w.Write([]byte{':'}); err != nil {
+			return err
+		}
+		e := rv.MapIndex(reflect.ValueOf(k).Convert(rv.Type().Key()))
+		if err := w.value(e, depth+1); err != nil {
+			return err
+		}
+	}
+	_, err := w.Write([]byte{'}'})
+	return err
+}
+
+func (w *cappedWriter) array(rv reflect.Value, depth int) error {
+	if _, err := w.Write([]byte{'['}); err != nil {
+		return err
+	}
+	for i := range rv.Len() {
+		if i > 0 {
+			if _, err := w.Write([]byte{','}); err != nil {
+				return err
+			}
+		}
+		if err := w.value(rv.Index(i), depth+1); err != nil {
+			return err
+		}
+	}
+	_, err := w.Write([]byte{']'})
+	return err
+}
+
+// isMarshaler reports whether json.Marshal marshals rv with its methods,
+// which includes those with a pointer receiver, if rv is addressable.
+func isMarshaler(rv reflect.Value) bool {
+	t := rv.Type()
+	if t.Implements(marshalerType) || t.Implements(textMarshalerType) {
+		return true
+	}
+	if rv.CanAddr() && t.Kind() != reflect.Pointer {
+		p := reflect.PointerTo(t)
+		return p.Implements(marshalerType) || p.Implements(textMarshalerType)
+	}
+	return false
+}
+
+// marshaled returns rv as json.Marshal sees it: a pointer to it,
+// if rv is addressable and its methods with a pointer receiver marshal it.
+func marshaled(rv reflect.Value) any {
+	if !rv.IsValid() {
+		return nil
+	}
+	if rv.CanAddr() && rv.Kind() != reflect.Pointer {
+		if p := rv.Addr(); p.Type().Implements(marshalerType) || p.Type().Implements(textMarshalerType) {
+			return p.Interface()
+		}
+	}
+	return rv.Interface()
+}
@@ -0,0 +1,112 @@
+package jason
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type ptrMarshaler struct{ n int }
+
+func (p *ptrMarshaler) MarshalJSON() ([]byte, error) {
+	return []byte(`"ptr"`), nil
+}
+
+type valMarshaler struct{ n int }
+
+func (v valMarshaler) MarshalText() ([]byte, error) {
+	return []byte("val"), nil
+}
+
+func TestFromCapped(t *testing.T) {
+	one := 1
+	tests := []struct {
+		name string
+		in   any
+	}{
+		{"nil", nil},
+		{"scalar", 1.5},
+		{"string", "<a&b>"},
+		{"bytes", []byte("abc")},
+		{"nil slice", []int(nil)},
+		{"nil map", map[string]int(nil)},
+		{"slice", []any{1, "a", nil, &one, []int{1, 2}}},
+		{"map", map[string]any{"b": 1, "a": map[string]int{"x": 2}}},
+		{"struct", struct{ A, B int }{1, 2}},
+		{"array of ptr marshalers", [2]ptrMarshaler{}},
+		{"pointer to array of ptr marshalers", &[2]ptrMarshaler{}},
+		{"slice of ptr marshalers", []ptrMarshaler{{}}},
+		{"map of ptr marshalers", map[string]ptrMarshaler{"a": {}}},
+		{"map of pointers to ptr marshalers", map[string]*ptrMarshaler{"a": {}, "b": nil}},
+		{"slice of val marshalers", []valMarshaler{{}}},
+		{"pointer to val marshaler", &valMarshaler{}},
+		{"tree", Object{"a": Array{Number("1"), true, RawValue(`{"x":1}`)}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want, err := TryFrom(tt.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := FromCapped(tt.in, -1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != string(want) {
+				t.Errorf("FromCapped() = %s, want %s", got, want)
+			}
+			if _, err := FromCapped(tt.in, len(want)); err != nil {
+				t.Errorf("FromCapped(%d) error = %v", len(want), err)
+			}
+			if _, err := FromCapped(tt.in, len(want)-1); !errors.Is(err, ErrTooLarge) {
+				t.Errorf("FromCapped(%d) error = %v, want ErrTooLarge", len(want)-1, err)
+			}
+		})
+	}
+}
+
+func TestFromCapped_cycle(t *testing.T) {
+	s := []any{nil}
+	s[0] = s
+	if _, err := FromCapped(s, -1); err == nil || errors.Is(err, ErrTooLarge) {
+		t.Errorf("FromCapped() error = %v, want a cycle error", err)
+	}
+}
+
+func TestAsALimited(t *testing.T) {
+	tests := []struct {
+		in       string
+		maxBytes int
+		opts     []LimitOption
+		want     []int
+		wantErr  error
+	}{
+		{in: `[1,2]`, maxBytes: 5, want: []int{1, 2}},
+		{in: `[1,2]`, maxBytes: 4, wantErr: ErrTooLarge},
+		{in: `[1,2]`, maxBytes: -1, want: []int{1, 2}},
+		{in: `[1,2]`, maxBytes: 100, opts: []LimitOption{MaxDepth(1)}, want: []int{1, 2}},
+		{in: `[1,2]`, maxBytes: 100, opts: []LimitOption{MaxDepth(0)}, wantErr: ErrTooDeep},
+		{in: `[[1]]`, maxBytes: 100, opts: []LimitOption{MaxDepth(1)}, wantErr: ErrTooDeep},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := AsALimited[[]int](RawValue(tt.in), tt.maxBytes, tt.opts...)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("AsALimited() error = %v, want %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AsALimited() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// The depth check stops before reaching the invalid data.
+	deep := RawValue(strings.Repeat("[", 100) + "x")
+	if _, err := AsALimited[any](deep, 1000, MaxDepth(10)); !errors.Is(err, ErrTooDeep) {
+		t.Errorf("AsALimited() error = %v, want ErrTooDeep", err)
+	}
+	if _, err := AsALimited[any](RawValue(`[1,`), 1000, MaxDepth(10)); err == nil {
+		t.Error("AsALimited() accepted invalid JSON")
+	}
+}
@@ -0,0 +1,157 @@
+package jason
+
+import "strconv"
+
+// MergeOption configures Merge.
+type MergeOption interface {
+	apply(*merger)
+}
+
+// ArrayMerge is a MergeOption that sets how Merge combines two arrays.
+type ArrayMerge int
+
+const (
+	// ReplaceArrays replaces dst arrays with src arrays (the default).
+	ReplaceArrays ArrayMerge = iota
+	// ConcatArrays appends src arrays to dst arrays.
+	ConcatArrays
+	// MergeArraysByIndex merges elements at the same index,
+	// as Merge merges values at the same key:
+	// objects are merged recursively, and other values,
+	// including elements of different types, are replaced.
+	// Extra elements from the longer array are kept.
+	MergeArraysByIndex
+	// MergeArraysUnion appends to dst arrays the elements of src arrays
+	// not yet present, keeping the first occurrence of each element.
+	// Elements are compared as by Equal, so objects with the same members
+	// in a different order are duplicates, as are duplicates within dst.
+	// Comparing each pair of elements would take quadratic time,
+	// so elements are bucketed by Hash first.
+	MergeArraysUnion
+)
+
+func (a ArrayMerge) apply(m *merger) { m.arrays = a }
+
+type conflictResolver func(path string, dst, src any) any
+
+func (f conflictResolver) apply(m *merger) { m.conflict = f }
+
+// OnConflict returns a MergeOption that calls fn to resolve conflicts:
+// values at the same path where one is an object or an array,
+// and the other is a value of a different type.
+// The value returned by fn, with its JSON Pointer path,
+// is stored in the merged object.
+//
+// Without this option, values from src win.
+func OnConflict(fn func(path string, dst, src any) any) MergeOption {
+	return conflictResolver(fn)
+}
+
+// Merge deep merges src into dst.
+//
+// Objects are merged recursively, arrays are combined as
+// set by an ArrayMerge option, and any other value in src
+// replaces the value in dst.
+// Values from src may be shared with dst.
+//
+// Example of layering configuration:
+//
+//	jason.Merge(cfg, overrides, jason.ConcatArrays)
+func Merge(dst, src Object, opts ...MergeOption) {
+	var m merger
+	for _, o := range opts {
+		o.apply(&m)
+	}
+	m.object(nil, dst, src)
+}
+
+type merger struct {
+	arrays   ArrayMerge
+	conflict conflictResolver
+}
+
+func (m *merger) object(path []string, dst, src Object) {
+	for k, s := range src {
+		if d, ok := dst[k]; ok {
+			dst[k] = m.value(append(path, k), d, s)
+		} else {
+			dst[k] = s
+		}
+	}
+}
+
+func (m *merger) value(path []string, dst, src any) any {
+	switch d := dst.(type) {
+	case Object:
+		if s, ok := src.(Object); ok {
+			m.object(path, d, s)
+			return d
+		}
+	case Array:
+		if s, ok := src.(Array); ok {
+			return m.array(path, d, s)
+		}
+	}
+	if m.conflict != nil && (isContainer(dst) || isContainer(src)) {
+		return m.conflict(JoinPointer(path...), dst, src)
+	}
+	return src
+}
+
+func (m *merger) array(path []string, dst, src Array) Array {
+	switch m.arrays {
+	case ConcatArrays:
+		return append(dst[:len(dst):len(dst)], src...)
+	case MergeArraysByIndex:
+		for i := 0; i < len(dst) && i < len(src); i++ {
+			dst[i] = m.value(append(path, strconv.Itoa(i)), dst[i], src[i])
+		}
+		if len(src) > len(dst) {
+			return append(dst[:len(dst):len(dst)], src[len(dst):]...)
+		}
+		return dst
+	case MergeArraysUnion:
+		return unionArrays(dst, src)
+	default:
+		return src
+	}
+}
+
+func isContainer(v any) bool {
+	switch v.(type) {
+	case Object, Array:
+		return true
+	}
+	return false
+}
+
+func unionArrays(dst, src Array) Array {
+	res := make(Array, 0, len(dst)+len(src))
+	seen := map[[32]byte][]any{}
+	var unhashed []any // values Hash fails on, compared to each other
+
+	for _, v := range append(dst[:len(dst):len(dst)], src...) {
+		if h, err := Hash(v); err == nil {
+			if containsEqual(seen[h], v) {
+				continue
+			}
+			seen[h] = append(seen[h], v)
+		} else {
+			if containsEqual(unhashed, v) {
+				continue
+			}
+			unhashed = append(unhashed, v)
+		}
+		res = append(res, v)
+	}
+	return res
+}
+
+func containsEqual(s []any, v any) bool {
+	for _, e := range s {
+		if equal(e, v) {
+			return true
+		}
+	}
+	return false
+}
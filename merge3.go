@@ -0,0 +1,113 @@
+package jason
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Conflict is a divergent change found by Merge3.
+//
+// Path is the JSON Pointer to the conflicting value,
+// and Base, Local and Remote are its versions;
+// a missing value, e.g. a deleted key, is reported as nil.
+type Conflict struct {
+	Path   string
+	Base   any
+	Local  any
+	Remote any
+}
+
+// Merge3 performs a three-way merge of the local and remote
+// changes to base.
+//
+// Keys changed on only one side, relative to base, take that change,
+// and keys changed in the same way on both sides take the common change;
+// deleting a key is a change.
+// Objects changed on both sides are merged recursively.
+// Other values, arrays included, changed differently on both sides are conflicts:
+// the merged object keeps their local version,
+// and the caller decides how to resolve them.
+// Values are compared as by Equal.
+// The inputs are not modified, but values from them may be shared
+// with the merged object.
+//
+// Example of merging concurrent edits to a configuration:
+//
+//	merged, conflicts, err := jason.Merge3(base, ours, theirs)
+//	if len(conflicts) > 0 { ... }
+func Merge3(base, local, remote Object) (Object, []Conflict, error) {
+	for _, o := range []Object{base, local, remote} {
+		if err := Walk(o, checkTree); err != nil {
+			return nil, nil, err
+		}
+	}
+	var m merge3
+	res := m.object(nil, base, local, remote)
+	return res, m.conflicts, nil
+}
+
+func checkTree(path string, v any) error {
+	switch v.(type) {
+	case Object, Array, Number, string, float64, bool, nil:
+		return nil
+	}
+	if _, ok := goNumber(v); ok {
+		return nil
+	}
+	return fmt.Errorf("jason: %s: cannot merge value of type %T", path, v)
+}
+
+type merge3 struct {
+	conflicts []Conflict
+}
+
+func (m *merge3) object(path []string, base, local, remote Object) Object {
+	keys := map[string]struct{}{}
+	for _, o := range []Object{base, local, remote} {
+		for k := range o {
+			keys[k] = struct{}{}
+		}
+	}
+	sorted := make([]string, 0, len(keys))
+	for k := range keys {
+		sorted = append(sorted, k)
+	}
+	sort.Strings(sorted)
+
+	res := Object{}
+	for _, k := range sorted {
+		b, inB := base[k]
+		l, inL := local[k]
+		r, inR := remote[k]
+
+		var v any
+		var ok bool
+		switch {
+		case inL == inR && equal(l, r):
+			v, ok = l, inL
+		case inL == inB && equal(l, b):
+			v, ok = r, inR
+		case inR == inB && equal(r, b):
+			v, ok = l, inL
+		default:
+			lo, lok := l.(Object)
+			ro, rok := r.(Object)
+			bo, bok := b.(Object)
+			if lok && rok && (bok || !inB) {
+				v, ok = m.object(append(path[:len(path):len(path)], k), bo, lo, ro), true
+				break
+			}
+			m.conflicts = append(m.conflicts, Conflict{
+				Path:   JoinPointer(append(path[:len(path):len(path)], k)...),
+				Base:   b,
+				Local:  l,
+				Remote: r,
+			})
+			v, ok = l, inL
+		}
+		if ok {
+			res[k] = v
+		}
+	}
+	return res
+}
@@ -0,0 +1,280 @@
+package jason
+
+import "testing"
+
+func TestMerge(t *testing.T) {
+	tests := []struct {
+		name     string
+		dst, src Object
+		opts     []MergeOption
+		want     Object
+	}{
+		{
+			name: "scalars",
+			dst:  Object{"a": 1, "b": 2},
+			src:  Object{"b": 3, "c": 4},
+			want: Object{"a": 1, "b": 3, "c": 4},
+		},
+		{
+			name: "nested",
+			dst:  Object{"a": Object{"b": 1, "c": Object{"d": 2}}},
+			src:  Object{"a": Object{"c": Object{"e": 3}}},
+			want: Object{"a": Object{"b": 1, "c": Object{"d": 2, "e": 3}}},
+		},
+		{
+			name: "null is a value",
+			dst:  Object{"a": 1},
+			src:  Object{"a": nil},
+			want: Object{"a": nil},
+		},
+		{
+			name: "replace arrays",
+			dst:  Object{"a": Array{1, 2}},
+			src:  Object{"a": Array{3}},
+			want: Object{"a": Array{3}},
+		},
+		{
+			name: "concat arrays",
+			dst:  Object{"a": Array{1, 2}},
+			src:  Object{"a": Array{2, 3}},
+			opts: []MergeOption{ConcatArrays},
+			want: Object{"a": Array{1, 2, 2, 3}},
+		},
+		{
+			name: "type change",
+			dst:  Object{"a": Object{"b": 1}},
+			src:  Object{"a": Array{1}},
+			want: Object{"a": Array{1}},
+		},
+		{
+			name: "on conflict",
+			dst:  Object{"a": Object{"b": Object{"c": 1}}, "x": 1},
+			src:  Object{"a": Object{"b": "s"}, "x": 2},
+			opts: []MergeOption{OnConflict(func(path string, dst, src any) any {
+				return Array{path, dst, src}
+			})},
+			want: Object{"a": Object{"b": Array{"/a/b", Object{"c": 1}, "s"}}, "x": 2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			Merge(tt.dst, tt.src, tt.opts...)
+			if !equal(tt.dst, tt.want) {
+				t.Errorf("Merge() = %v, want %v", tt.dst, tt.want)
+			}
+		})
+	}
+}
+
+func TestMerge_concatDoesNotAlias(t *testing.T) {
+	arr := make(Array, 1, 4)
+	arr[0] = 1
+	dst := Object{"a": arr}
+	Merge(dst, Object{"a": Array{2}}, ConcatArrays)
+	if arr[:2][1] != nil {
+		t.Error("Merge() appended in place, into the capacity of the dst array")
+	}
+}
+
+func TestMerge_union(t *testing.T) {
+	tests := []struct {
+		name     string
+		dst, src Object
+		want     Array
+	}{
+		{
+			name: "ints",
+			dst:  Object{"a": Array{1, 2}},
+			src:  Object{"a": Array{2, 3}},
+			want: Array{1, 2, 3},
+		},
+		{
+			name: "mixed numbers",
+			dst:  Object{"a": Array{int64(1), uint8(2), 2.5}},
+			src:  Object{"a": Array{Number("1.0"), float32(2.5), 2}},
+			want: Array{int64(1), uint8(2), 2.5},
+		},
+		{
+			name: "objects",
+			dst:  Object{"a": Array{Object{"k": 1}}},
+			src:  Object{"a": Array{Object{"k": 1}, Object{"k": 2}}},
+			want: Array{Object{"k": 1}, Object{"k": 2}},
+		},
+		{
+			name: "duplicates in dst",
+			dst:  Object{"a": Array{"x", "x", Array{1, 2}}},
+			src:  Object{"a": Array{Array{1, 2}, "y"}},
+			want: Array{"x", Array{1, 2}, "y"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			Merge(tt.dst, tt.src, MergeArraysUnion)
+			if got := tt.dst["a"]; !equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMerge_byIndex(t *testing.T) {
+	tests := []struct {
+		name     string
+		dst, src Object
+		want     Array
+	}{
+		{
+			name: "scalars",
+			dst:  Object{"a": Array{1, 2, 3}},
+			src:  Object{"a": Array{4, nil}},
+			want: Array{4, nil, 3},
+		},
+		{
+			name: "longer src",
+			dst:  Object{"a": Array{1}},
+			src:  Object{"a": Array{2, 3}},
+			want: Array{2, 3},
+		},
+		{
+			name: "objects",
+			dst:  Object{"a": Array{Object{"x": 1, "y": 1}, Object{"x": 1}}},
+			src:  Object{"a": Array{Object{"y": 2}, "s"}},
+			want: Array{Object{"x": 1, "y": 2}, "s"},
+		},
+		{
+			name: "nested arrays",
+			dst:  Object{"a": Array{Array{1, 2}}},
+			src:  Object{"a": Array{Array{3}}},
+			want: Array{Array{3, 2}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			Merge(tt.dst, tt.src, MergeArraysByIndex)
+			if got := tt.dst["a"]; !equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	var paths []string
+	Merge(Object{"a": Array{1, Object{}}}, Object{"a": Array{2, Array{}}},
+		MergeArraysByIndex, OnConflict(func(path string, dst, src any) any {
+			paths = append(paths, path)
+			return src
+		}))
+	if len(paths) != 1 || paths[0] != "/a/1" {
+		t.Errorf("OnConflict() called with %q, want [/a/1]", paths)
+	}
+
+	arr := make(Array, 1, 4)
+	arr[0] = 1
+	Merge(Object{"a": arr}, Object{"a": Array{2, 3}}, MergeArraysByIndex)
+	if arr[:2][1] != nil {
+		t.Error("Merge() appended in place, into the capacity of the dst array")
+	}
+}
+
+func TestMerge3(t *testing.T) {
+	tests := []struct {
+		name                string
+		base, local, remote Object
+		want                Object
+		conflicts           []Conflict
+	}{
+		{
+			name:   "one side",
+			base:   Object{"a": 1, "b": 1, "c": 1},
+			local:  Object{"a": 2, "b": 1, "c": 1},
+			remote: Object{"a": 1, "b": 3, "d": 4},
+			want:   Object{"a": 2, "b": 3, "d": 4},
+		},
+		{
+			name:   "same change",
+			base:   Object{"a": 1, "b": 1},
+			local:  Object{"a": 2},
+			remote: Object{"a": 2.0},
+			want:   Object{"a": 2},
+		},
+		{
+			name:   "nested",
+			base:   Object{"o": Object{"x": 1, "y": 1}},
+			local:  Object{"o": Object{"x": 2, "y": 1}},
+			remote: Object{"o": Object{"x": 1, "y": 3, "z": 4}},
+			want:   Object{"o": Object{"x": 2, "y": 3, "z": 4}},
+		},
+		{
+			name:   "added on both sides",
+			base:   Object{},
+			local:  Object{"o": Object{"x": 1}},
+			remote: Object{"o": Object{"y": 2}},
+			want:   Object{"o": Object{"x": 1, "y": 2}},
+		},
+		{
+			name:      "conflict",
+			base:      Object{"o": Object{"a": 1}},
+			local:     Object{"o": Object{"a": 2}},
+			remote:    Object{"o": Object{"a": 3}},
+			want:      Object{"o": Object{"a": 2}},
+			conflicts: []Conflict{{Path: "/o/a", Base: 1, Local: 2, Remote: 3}},
+		},
+		{
+			name:      "arrays",
+			base:      Object{"a": Array{1}},
+			local:     Object{"a": Array{1, 2}},
+			remote:    Object{"a": Array{0, 1}},
+			want:      Object{"a": Array{1, 2}},
+			conflicts: []Conflict{{Path: "/a", Base: Array{1}, Local: Array{1, 2}, Remote: Array{0, 1}}},
+		},
+		{
+			name:      "delete and change",
+			base:      Object{"a": 1, "b": 1},
+			local:     Object{"b": 2},
+			remote:    Object{"a": 3},
+			want:      Object{"b": 2},
+			conflicts: []Conflict{{Path: "/a", Base: 1, Remote: 3}, {Path: "/b", Base: 1, Local: 2}},
+		},
+		{
+			name:      "null is a value",
+			base:      Object{"a": 1},
+			local:     Object{"a": nil},
+			remote:    Object{},
+			want:      Object{"a": nil},
+			conflicts: []Conflict{{Path: "/a", Base: 1}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, conflicts, err := Merge3(tt.base, tt.local, tt.remote)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !equal(got, tt.want) {
+				t.Errorf("Merge3() = %v, want %v", got, tt.want)
+			}
+			if len(conflicts) != len(tt.conflicts) {
+				t.Fatalf("Merge3() conflicts = %v, want %v", conflicts, tt.conflicts)
+			}
+			for i, c := range conflicts {
+				w := tt.conflicts[i]
+				if c.Path != w.Path || !equal(c.Base, w.Base) || !equal(c.Local, w.Local) || !equal(c.Remote, w.Remote) {
+					t.Errorf("Merge3() conflict %d = %v, want %v", i, c, w)
+				}
+			}
+		})
+	}
+
+	if _, _, err := Merge3(Object{}, Object{"f": func() {}}, Object{}); err == nil {
+		t.Error("Merge3() accepted a func")
+	}
+}
+
+func TestMerge3_literals(t *testing.T) {
+	got, conflicts, err := Merge3(Object{"a": 1, "b": 1}, Object{"a": 2, "b": 1}, Object{"a": 1, "b": uint(3)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (Object{"a": 2, "b": 3}); !equal(got, want) || len(conflicts) != 0 {
+		t.Errorf("got %v %v, want %v", got, conflicts, want)
+	}
+}
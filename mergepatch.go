@@ -0,0 +1,50 @@
+package jason
+
+// MergePatch applies the JSON Merge Patch (RFC 7386) patch to target,
+// and returns the patched document, panics on error.
+//
+// Objects are merged recursively, a null in patch removes a key,
+// and any other value, including arrays, replaces the target.
+//
+// Example of removing a key from a configuration overlay:
+//
+//	jason.MergePatch(config, jason.RawValue(`{"debug": null}`))
+func MergePatch(target, patch RawValue) RawValue {
+	t, err := decodeTree(target)
+	if err != nil {
+		panic(err)
+	}
+	p, err := decodeTree(patch)
+	if err != nil {
+		panic(err)
+	}
+	return From(mergePatch(t, p))
+}
+
+// MergePatchInto applies the JSON Merge Patch (RFC 7386) patch
+// to the Object pointed to by target, modifying it in place.
+//
+// A nil Object is replaced by a new one.
+// Values from patch may be shared with the patched Object.
+func MergePatchInto(target *Object, patch Object) {
+	*target = mergePatch(*target, patch).(Object)
+}
+
+func mergePatch(target, patch any) any {
+	p, ok := patch.(Object)
+	if !ok {
+		return patch
+	}
+	t, ok := target.(Object)
+	if !ok || t == nil {
+		t = Object{}
+	}
+	for k, v := range p {
+		if v == nil {
+			delete(t, k)
+		} else {
+			t[k] = mergePatch(t[k], v)
+		}
+	}
+	return t
+}
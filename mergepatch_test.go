@@ -0,0 +1,52 @@
+package jason
+
+import "testing"
+
+// Tests from RFC 7386, Appendix A.
+func TestMergePatch(t *testing.T) {
+	tests := []struct {
+		target, patch, want string
+	}{
+		{`{"a":"b"}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"b"}`, `{"b":"c"}`, `{"a":"b","b":"c"}`},
+		{`{"a":"b"}`, `{"a":null}`, `{}`},
+		{`{"a":"b","b":"c"}`, `{"a":null}`, `{"b":"c"}`},
+		{`{"a":["b"]}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"c"}`, `{"a":["b"]}`, `{"a":["b"]}`},
+		{`{"a":{"b":"c"}}`, `{"a":{"b":"d","c":null}}`, `{"a":{"b":"d"}}`},
+		{`{"a":[{"b":"c"}]}`, `{"a":[1]}`, `{"a":[1]}`},
+		{`["a","b"]`, `["c","d"]`, `["c","d"]`},
+		{`{"a":"b"}`, `["c"]`, `["c"]`},
+		{`{"a":"foo"}`, `null`, `null`},
+		{`{"a":"foo"}`, `"bar"`, `"bar"`},
+		{`{"e":null}`, `{"a":1}`, `{"e":null,"a":1}`},
+		{`[1,2]`, `{"a":"b","c":null}`, `{"a":"b"}`},
+		{`{}`, `{"a":{"bb":{"ccc":null}}}`, `{"a":{"bb":{}}}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.target+" "+tt.patch, func(t *testing.T) {
+			got := MergePatch(RawValue(tt.target), RawValue(tt.patch))
+			if !Equal(got, RawValue(tt.want)) {
+				t.Errorf("MergePatch() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMergePatch_invalid(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("MergePatch() did not panic")
+		}
+	}()
+	MergePatch(RawValue(`{`), RawValue(`{}`))
+}
+
+func TestMergePatchInto(t *testing.T) {
+	var o Object
+	MergePatchInto(&o, Object{"a": Object{"b": 1, "c": nil}})
+	MergePatchInto(&o, Object{"d": 2})
+	if want := (Object{"a": Object{"b": 1}, "d": 2}); !equal(o, want) {
+		t.Errorf("MergePatchInto() = %v, want %v", o, want)
+	}
+}
@@ -0,0 +1,114 @@
+package jason
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+)
+
+// MultiKeyOption configures AsTreeMultiKey.
+type MultiKeyOption uint
+
+const (
+	// OnlyDuplicates collects the values of repeated keys only,
+	// and decodes the value of other keys as usual.
+	OnlyDuplicates MultiKeyOption = 1 << iota
+)
+
+// AsTreeMultiKey decodes j into a tree, like AsTree,
+// but preserving the values of duplicate object keys.
+//
+// The values of each key are collected, in order and as raw JSON,
+// into a RawArray: {"a":1,"a":{"b":2}} decodes as
+// Object{"a": RawArray{`1`, `{"b":2}`}}.
+// The RawArray type distinguishes collected values from JSON arrays;
+// collected values can in turn be decoded with AsTreeMultiKey.
+//
+// With OnlyDuplicates, keys that are not repeated
+// decode to their value: {"a":1,"a":2,"c":3} decodes as
+// Object{"a": RawArray{`1`, `2`}, "c": Number("3")}.
+func AsTreeMultiKey(j RawValue, opts ...MultiKeyOption) (any, error) {
+	var o MultiKeyOption
+	for _, opt := range opts {
+		o |= opt
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(j))
+	dec.UseNumber()
+	m := multiKey{data: j, dec: dec, dups: o&OnlyDuplicates != 0}
+	v, err := m.decode()
+	if err != nil {
+		return nil, unexpectedEOF(err)
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		if err == nil {
+			err = errTrailingData
+		}
+		return nil, err
+	}
+	return v, nil
+}
+
+type multiKey struct {
+	data RawValue
+	dec  *json.Decoder
+	dups bool
+}
+
+func (m *multiKey) decode() (any, error) {
+	t, err := m.dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	switch t {
+	case json.Delim('{'):
+		type value struct {
+			tree any
+			raw  RawValue
+		}
+		values := map[string][]value{}
+		for m.dec.More() {
+			k, err := m.dec.Token()
+			if err != nil {
+				return nil, err
+			}
+			start := m.dec.InputOffset()
+			v, err := m.decode()
+			if err != nil {
+				return nil, err
+			}
+			raw := trimValue(m.data[start:m.dec.InputOffset()])
+			values[k.(string)] = append(values[k.(string)], value{v, raw})
+		}
+		if _, err := m.dec.Token(); err != nil {
+			return nil, err
+		}
+
+		obj := make(Object, len(values))
+		for k, vs := range values {
+			if m.dups && len(vs) == 1 {
+				obj[k] = vs[0].tree
+				continue
+			}
+			arr := make(RawArray, len(vs))
+			for i, v := range vs {
+				arr[i] = append(RawValue(nil), v.raw...)
+			}
+			obj[k] = arr
+		}
+		return obj, nil
+
+	case json.Delim('['):
+		arr := Array{}
+		for m.dec.More() {
+			v, err := m.decode()
+			if err != nil {
+				return nil, err
+			}
+			arr = append(arr, v)
+		}
+		_, err := m.dec.Token()
+		return arr, err
+	}
+	return t, nil
+}
@@ -0,0 +1,83 @@
+package jason
+
+import "testing"
+
+func TestAsTreeMultiKey(t *testing.T) {
+	tests := []struct {
+		in      string
+		opts    []MultiKeyOption
+		want    any
+		wantErr bool
+	}{
+		{in: `1`, want: Number("1")},
+		{in: `{"a":1,"a":{"b":2}}`, want: Object{"a": RawArray{RawValue(`1`), RawValue(`{"b":2}`)}}},
+		{in: `{"a":1,"a":2,"c":3}`, opts: []MultiKeyOption{OnlyDuplicates},
+			want: Object{"a": RawArray{RawValue(`1`), RawValue(`2`)}, "c": Number("3")}},
+		{in: `[{"a": 1 }]`, want: Array{Object{"a": RawArray{RawValue(`1`)}}}},
+		{in: `{"a":1`, wantErr: true},
+		{in: `{} {}`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := AsTreeMultiKey(RawValue(tt.in), tt.opts...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("AsTreeMultiKey() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !equalMultiKey(got, tt.want) {
+				t.Errorf("AsTreeMultiKey() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAsTreeMultiKey_copies(t *testing.T) {
+	j := RawValue(`{"a":1,"a":2}`)
+	got, err := AsTreeMultiKey(j)
+	if err != nil {
+		t.Fatal(err)
+	}
+	copy(j, `{"a":3,"a":4}`)
+	want := Object{"a": RawArray{RawValue(`1`), RawValue(`2`)}}
+	if !equalMultiKey(got, want) {
+		t.Errorf("result changed with its input: %v", got)
+	}
+}
+
+func equalMultiKey(a, b any) bool {
+	switch a := a.(type) {
+	case RawArray:
+		b, ok := b.(RawArray)
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if string(a[i]) != string(b[i]) {
+				return false
+			}
+		}
+		return true
+	case Object:
+		b, ok := b.(Object)
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for k, v := range a {
+			if !equalMultiKey(v, b[k]) {
+				return false
+			}
+		}
+		return true
+	case Array:
+		b, ok := b.(Array)
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if !equalMultiKey(a[i], b[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	return equal(a, b)
+}
@@ -0,0 +1,83 @@
+package jason
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+)
+
+// LineReader reads newline delimited JSON (NDJSON, JSON Lines)
+// from an input stream, one value per line.
+type LineReader struct {
+	r    *bufio.Reader
+	line int
+	err  error
+}
+
+// NewLineReader returns a new LineReader that reads from r.
+//
+// Example of reading the records of a JSON Lines file:
+//
+//	lr := jason.NewLineReader(f)
+//	for {
+//		j, err := lr.Next()
+//		if err == io.EOF { break }
+//		...
+//	}
+func NewLineReader(r io.Reader) *LineReader {
+	return &LineReader{r: bufio.NewReader(r)}
+}
+
+// Next returns the value in the next non-blank line.
+// It returns [io.EOF] after the last value.
+//
+// Lines can end in "\n" or "\r\n".
+// Errors report the number of the offending line.
+func (r *LineReader) Next() (RawValue, error) {
+	for r.err == nil {
+		line, err := r.r.ReadBytes('\n')
+		if err != nil {
+			r.err = err
+			if err != io.EOF {
+				return nil, err
+			}
+		}
+		if len(line) == 0 {
+			continue
+		}
+
+		r.line++
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+		if err := Validate(line); err != nil {
+			r.err = fmt.Errorf("jason: line %d: %w", r.line, err)
+			return nil, r.err
+		}
+		return line, nil
+	}
+	return nil, r.err
+}
+
+// LineWriter writes newline delimited JSON (NDJSON, JSON Lines)
+// to an output stream, one value per line.
+type LineWriter struct {
+	w io.Writer
+}
+
+// NewLineWriter returns a new LineWriter that writes to w.
+func NewLineWriter(w io.Writer) *LineWriter {
+	return &LineWriter{w: w}
+}
+
+// Write marshals v, and writes it to the stream followed by a newline.
+func (w *LineWriter) Write(v any) error {
+	b, err := TryFrom(v)
+	if err != nil {
+		return err
+	}
+	_, err = w.w.Write(append(b, '\n'))
+	return err
+}
@@ -0,0 +1,71 @@
+package jason
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLineReader(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		want    []string
+		wantErr string
+	}{
+		{name: "empty", in: ""},
+		{name: "lines", in: "{\"a\":1}\n[1, 2]\n\"x\"\n", want: []string{`{"a":1}`, `[1, 2]`, `"x"`}},
+		{name: "crlf and blanks", in: "1\r\n\r\n  \n 2 \r\n3", want: []string{`1`, `2`, `3`}},
+		{name: "invalid", in: "1\n\n{\n2\n", want: []string{`1`}, wantErr: "line 3"},
+		{name: "two values", in: "1 2\n", wantErr: "line 1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lr := NewLineReader(strings.NewReader(tt.in))
+			var got []string
+			var err error
+			for {
+				var j RawValue
+				if j, err = lr.Next(); err != nil {
+					break
+				}
+				got = append(got, string(j))
+			}
+			if tt.wantErr == "" && err != io.EOF {
+				t.Errorf("Next() error = %v, want EOF", err)
+			}
+			if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Errorf("Next() error = %v, want %q", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Next() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+type errWriter struct{}
+
+func (errWriter) Write([]byte) (int, error) { return 0, errors.New("write failed") }
+
+func TestLineWriter(t *testing.T) {
+	var buf bytes.Buffer
+	lw := NewLineWriter(&buf)
+	for _, v := range []any{Object{"a": 1}, RawValue("[\n  1\n]"), "x"} {
+		if err := lw.Write(v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got, want := buf.String(), "{\"a\":1}\n[1]\n\"x\"\n"; got != want {
+		t.Errorf("Write() wrote %q, want %q", got, want)
+	}
+	if err := lw.Write(make(chan int)); err == nil {
+		t.Error("Write() of a chan succeeded")
+	}
+	if err := NewLineWriter(errWriter{}).Write(1); err == nil {
+		t.Error("Write() to a failing writer succeeded")
+	}
+}
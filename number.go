@@ -0,0 +1,293 @@
+package jason
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// ToBigInt converts n into a [big.Int],
+// if n is an integer, such as "5", "5.0" or "5e2".
+func ToBigInt(n Number) (*big.Int, error) {
+	r, err := ToBigRat(n)
+	if err != nil {
+		return nil, err
+	}
+	if !r.IsInt() {
+		return nil, fmt.Errorf("jason: number %s is not an integer", n)
+	}
+	return r.Num(), nil
+}
+
+// ToBigRat converts n into a [big.Rat], without loss of precision.
+func ToBigRat(n Number) (*big.Rat, error) {
+	if validNumber(n) {
+		if r, ok := new(big.Rat).SetString(string(n)); ok {
+			return r, nil
+		}
+	}
+	return nil, fmt.Errorf("jason: invalid number literal %q", n)
+}
+
+// IsInteger reports whether n is an integer,
+// such as "5", "5.0" or "5e2", but not "5.5".
+func IsInteger(n Number) bool {
+	r, err := ToBigRat(n)
+	return err == nil && r.IsInt()
+}
+
+// CheckNumbers calls fn for every number in j, in document order,
+// with the JSON Pointer to the number.
+//
+// The walk stops at the first error returned by fn,
+// which is returned as is, or at any syntax error in j.
+//
+// Example of rejecting IDs that don't fit in a bigint column:
+//
+//	err := jason.CheckNumbers(j, func(path string, n jason.Number) error {
+//		if _, err := n.Int64(); err != nil {
+//			return fmt.Errorf("%s: %w", path, err)
+//		}
+//		return nil
+//	})
+func CheckNumbers(j RawValue, fn func(path string, n Number) error) error {
+	for tok, err := range Tokens(j) {
+		if err != nil {
+			return err
+		}
+		if n, ok := tok.Value.(Number); ok {
+			if err := fn(tok.Path, n); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// validNumber reports whether n is a valid JSON number literal.
+func validNumber(n Number) bool {
+	if n == "" {
+		return false
+	}
+	first, last := n[0], n[len(n)-1]
+	return (first == '-' || '0' <= first && first <= '9') &&
+		'0' <= last && last <= '9' && json.Valid([]byte(n))
+}
+
+// ratNumber converts r, which must have
+// a finite decimal expansion, into a Number.
+func ratNumber(r *big.Rat) (Number, error) {
+	if r.IsInt() {
+		return Number(r.Num().String()), nil
+	}
+
+	// A fraction with a denominator of 2^a*5^b
+	// has max(a, b) decimal digits.
+	d := new(big.Int).Set(r.Denom())
+	twos := int(d.TrailingZeroBits())
+	d.Rsh(d, uint(twos))
+
+	fives := 0
+	q, m := new(big.Int), new(big.Int)
+	for five := big.NewInt(5); ; fives++ {
+		if q.QuoRem(d, five, m); m.Sign() != 0 {
+			break
+		}
+		d.Set(q)
+	}
+	if !d.IsInt64() || d.Int64() != 1 {
+		return "", fmt.Errorf("jason: number %s has no finite decimal expansion", r)
+	}
+
+	digits := twos
+	if fives > digits {
+		digits = fives
+	}
+	return Number(r.FloatString(digits)), nil
+}
+
+// NumberMode sets how FromNumbers writes numbers.
+type NumberMode int
+
+const (
+	// NumbersAsIs writes numbers as marshaled (the default).
+	NumbersAsIs NumberMode = iota
+	// NumbersAsStrings writes numbers as strings holding their literals,
+	// so clients that decode numbers as float64 keep their precision.
+	NumbersAsStrings
+	// NumbersShortest writes numbers in the shortest form
+	// with the same exact value, like 1.5 for 1.50, or 1e21 for 10e20.
+	NumbersShortest
+)
+
+// FromNumbers marshals v into a RawValue, like TryFrom,
+// but rewrites every number as set by mode.
+// Everything else is left intact.
+//
+// Example of sending 64-bit IDs to a JavaScript client:
+//
+//	j, err := jason.FromNumbers(resp, jason.NumbersAsStrings)
+func FromNumbers(v any, mode NumberMode) (RawValue, error) {
+	j, err := TryFrom(v)
+	if err != nil {
+		return nil, err
+	}
+	switch mode {
+	case NumbersAsStrings:
+		return rewriteNumbers(j, func(n []byte) []byte {
+			return append(append([]byte{'"'}, n...), '"')
+		}), nil
+	case NumbersShortest:
+		return rewriteNumbers(j, shortestNumber), nil
+	}
+	return j, nil
+}
+
+// ParseStringNumbers reverses NumbersAsStrings:
+// it returns a copy of j where every string value that holds
+// a valid number literal, without escapes, is replaced by the number.
+//
+// Object keys are left as is.
+// Note that strings that were never numbers, like a zip code "12345",
+// are converted as well, if they are valid number literals;
+// "01234", with its leading zero, is not one.
+//
+// Example of decoding a document from a JavaScript client:
+//
+//	j, err = jason.ParseStringNumbers(j)
+func ParseStringNumbers(j RawValue) (RawValue, error) {
+	if err := Validate(j); err != nil {
+		return nil, err
+	}
+
+	res := make(RawValue, 0, len(j))
+	for i := 0; i < len(j); i++ {
+		if j[i] != '"' {
+			res = append(res, j[i])
+			continue
+		}
+
+		end, escaped := i+1, false
+		for ; j[end] != '"'; end++ {
+			if j[end] == '\\' {
+				escaped = true
+				end++
+			}
+		}
+
+		next := end + 1
+		for next < len(j) && isSpace(j[next]) {
+			next++
+		}
+		lit := j[i+1 : end]
+		if !escaped && (next == len(j) || j[next] != ':') && validNumber(Number(lit)) {
+			res = append(res, lit...)
+		} else {
+			res = append(res, j[i:end+1]...)
+		}
+		i = end
+	}
+	return res, nil
+}
+
+// rewriteNumbers returns a copy of the valid JSON j
+// with every number literal n replaced by fn(n).
+func rewriteNumbers(j RawValue, fn func(n []byte) []byte) RawValue {
+	res := make(RawValue, 0, len(j))
+	str := false
+	for i := 0; i < len(j); i++ {
+		switch c := j[i]; {
+		case str && c == '\\':
+			res = append(res, c, j[i+1])
+			i++
+			continue
+		case c == '"':
+			str = !str
+		case !str && (c == '-' || '0' <= c && c <= '9'):
+			end := i + 1
+			for end < len(j) && isNumberByte(j[end]) {
+				end++
+			}
+			res = append(res, fn(j[i:end])...)
+			i = end - 1
+			continue
+		}
+		res = append(res, j[i])
+	}
+	return res
+}
+
+func isNumberByte(c byte) bool {
+	return '0' <= c && c <= '9' || c == '-' || c == '+' || c == '.' || c == 'e' || c == 'E'
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\r' || c == '\n'
+}
+
+// shortestNumber returns the shortest literal
+// with the same exact value as the number literal n.
+func shortestNumber(n []byte) []byte {
+	s := string(n)
+	neg := strings.HasPrefix(s, "-")
+	s = strings.TrimPrefix(s, "-")
+
+	// Split s into digits, and the exponent of their last digit.
+	exp := 0
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		e, err := strconv.Atoi(s[i+1:])
+		if err != nil || e > math.MaxInt32 || e < math.MinInt32 {
+			return n
+		}
+		s, exp = s[:i], e
+	}
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		exp -= len(s) - i - 1
+		s = s[:i] + s[i+1:]
+	}
+	s = strings.TrimLeft(s, "0")
+	for strings.HasSuffix(s, "0") {
+		s = s[:len(s)-1]
+		exp++
+	}
+	if s == "" {
+		return []byte("0")
+	}
+
+	// Exponent notation: with an integer mantissa, or normalized.
+	best := ""
+	for _, alt := range []string{
+		s + "e" + strconv.Itoa(exp),
+		s[:1] + "." + s[1:] + "e" + strconv.Itoa(exp+len(s)-1),
+	} {
+		alt = strings.Replace(alt, ".e", "e", 1)
+		if best == "" || len(alt) < len(best) {
+			best = alt
+		}
+	}
+
+	// Plain decimal notation, built only if it is no longer,
+	// since large exponents take many zeros.
+	point := len(s) + exp
+	switch {
+	case exp >= 0:
+		if len(s)+exp <= len(best) {
+			best = s + strings.Repeat("0", exp)
+		}
+	case point > 0:
+		if len(s)+1 <= len(best) {
+			best = s[:point] + "." + s[point:]
+		}
+	default:
+		if 2-point+len(s) <= len(best) {
+			best = "0." + strings.Repeat("0", -point) + s
+		}
+	}
+	if neg {
+		best = "-" + best
+	}
+	return []byte(best)
+}
@@ -0,0 +1,195 @@
+package jason
+
+import (
+	"errors"
+	"reflect"
+	"runtime"
+	"testing"
+)
+
+func TestToBigInt(t *testing.T) {
+	tests := []struct {
+		in      Number
+		want    string
+		wantErr bool
+	}{
+		{in: "5", want: "5"},
+		{in: "-5.0", want: "-5"},
+		{in: "5e2", want: "500"},
+		{in: "12345678901234567890123", want: "12345678901234567890123"},
+		{in: "1.5e1", want: "15"},
+		{in: "5.5", wantErr: true},
+		{in: "1e-1", wantErr: true},
+		{in: "0x10", wantErr: true},
+		{in: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.in), func(t *testing.T) {
+			got, err := ToBigInt(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ToBigInt() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got.String() != tt.want {
+				t.Errorf("ToBigInt() = %v, want %s", got, tt.want)
+			}
+			if got := IsInteger(tt.in); got != !tt.wantErr {
+				t.Errorf("IsInteger() = %v, want %v", got, !tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestToBigRat(t *testing.T) {
+	tests := []struct {
+		in      Number
+		want    string
+		wantErr bool
+	}{
+		{in: "0.1", want: "1/10"},
+		{in: "-2.50", want: "-5/2"},
+		{in: "1e-30", want: "1/1000000000000000000000000000000"},
+		{in: "1/2", wantErr: true},
+		{in: "+1", wantErr: true},
+		{in: "1.", wantErr: true},
+		{in: "Inf", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.in), func(t *testing.T) {
+			got, err := ToBigRat(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ToBigRat() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got.String() != tt.want {
+				t.Errorf("ToBigRat() = %v, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_shortestNumber(t *testing.T) {
+	tests := []struct{ in, want string }{
+		{"0", "0"},
+		{"-0.0", "0"},
+		{"1.50", "1.5"},
+		{"100", "100"},
+		{"1000", "1e3"},
+		{"10e20", "1e21"},
+		{"0.001", "1e-3"},
+		{"0.5", "0.5"},
+		{"-5e-1", "-0.5"},
+		{"123456e-3", "123.456"},
+		{"1.23e-10", "123e-12"},
+		{"12.3400e2", "1234"},
+		{"1e50000000", "1e50000000"},
+		{"1E-999999999", "1e-999999999"},
+		{"1e99999999999", "1e99999999999"},
+	}
+	for _, tt := range tests {
+		if got := shortestNumber([]byte(tt.in)); string(got) != tt.want {
+			t.Errorf("shortestNumber(%s) = %s, want %s", tt.in, got, tt.want)
+		}
+	}
+}
+
+func Test_shortestNumber_memory(t *testing.T) {
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	shortestNumber([]byte("1e999999999"))
+	runtime.ReadMemStats(&after)
+	if n := after.TotalAlloc - before.TotalAlloc; n > 1<<20 {
+		t.Errorf("allocated %d bytes", n)
+	}
+}
+
+func TestCheckNumbers(t *testing.T) {
+	type found struct {
+		path string
+		n    Number
+	}
+	var got []found
+	err := CheckNumbers(RawValue(`{"a":[1,{"b":2.50}],"c":"3","d/e":-1e9,"4":true}`), func(path string, n Number) error {
+		got = append(got, found{path, n})
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []found{{"/a/0", "1"}, {"/a/1/b", "2.50"}, {"/d~1e", "-1e9"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("CheckNumbers() visited %v, want %v", got, want)
+	}
+
+	errFn := errors.New("fn failed")
+	calls := 0
+	err = CheckNumbers(RawValue(`[1, 2, 3]`), func(path string, n Number) error {
+		if calls++; n == "2" {
+			return errFn
+		}
+		return nil
+	})
+	if err != errFn || calls != 2 {
+		t.Errorf("CheckNumbers() = %v after %d calls, want %v after 2", err, calls, errFn)
+	}
+
+	if err := CheckNumbers(RawValue(`[1, 2`), func(string, Number) error { return nil }); err == nil {
+		t.Error("CheckNumbers() accepted invalid JSON")
+	}
+}
+
+func TestFromNumbers(t *testing.T) {
+	v := Object{"id": Number("12345678901234567890"), "x": 1.50, "y": Number("10e20"), "s": "1", "n": Array{Number("-0.0"), true}}
+	tests := []struct {
+		mode NumberMode
+		want string
+	}{
+		{NumbersAsIs, `{"id":12345678901234567890,"n":[-0.0,true],"s":"1","x":1.5,"y":10e20}`},
+		{NumbersAsStrings, `{"id":"12345678901234567890","n":["-0.0",true],"s":"1","x":"1.5","y":"10e20"}`},
+		{NumbersShortest, `{"id":12345678901234567890,"n":[0,true],"s":"1","x":1.5,"y":1e21}`},
+	}
+	for _, tt := range tests {
+		got, err := FromNumbers(v, tt.mode)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != tt.want {
+			t.Errorf("FromNumbers(%d) = %s, want %s", tt.mode, got, tt.want)
+		}
+	}
+	if _, err := FromNumbers(func() {}, NumbersAsStrings); err == nil {
+		t.Error("FromNumbers() accepted a func")
+	}
+}
+
+func TestParseStringNumbers(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{`"12345678901234567890"`, `12345678901234567890`},
+		{`{"id": "1.5e3", "zip": "01234", "name": "x1"}`, `{"id": 1.5e3, "zip": "01234", "name": "x1"}`},
+		{`{"1": "2", "3" : {"4":"-5"}}`, `{"1": 2, "3" : {"4":-5}}`},
+		{`["1", "1\"", " 1", "1 ", "-", "1."]`, `[1, "1\"", " 1", "1 ", "-", "1."]`},
+		{`[1, "2", true]`, `[1, 2, true]`},
+	}
+	for _, tt := range tests {
+		got, err := ParseStringNumbers(RawValue(tt.in))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != tt.want {
+			t.Errorf("ParseStringNumbers(%s) = %s, want %s", tt.in, got, tt.want)
+		}
+	}
+
+	v := Object{"id": Number("12345678901234567890"), "list": Array{1, 2.5}}
+	j, err := FromNumbers(v, NumbersAsStrings)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, err := ParseStringNumbers(j); err != nil || !Equal(got, From(v)) {
+		t.Errorf("ParseStringNumbers() = %s, %v, want %s", got, err, From(v))
+	}
+	if _, err := ParseStringNumbers(RawValue(`["1"`)); err == nil {
+		t.Error("ParseStringNumbers() accepted invalid JSON")
+	}
+}
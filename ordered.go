@@ -0,0 +1,163 @@
+package jason
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// OrderedObject is a JSON object that preserves the order of its keys.
+//
+// It is backed by a slice of name/value pairs,
+// and implements [json.Marshaler] and [json.Unmarshaler]
+// to round-trip keys in their insertion order.
+//
+// When unmarshaling, nested objects are decoded as *OrderedObject,
+// arrays as Array, and numbers as Number.
+//
+// Example of decoding an object while keeping the order of its keys:
+//
+//	var o jason.OrderedObject
+//	err := json.Unmarshal(j, &o)
+type OrderedObject struct {
+	members []member
+}
+
+type member struct {
+	key   string
+	value any
+}
+
+// Len returns the number of keys in o.
+func (o OrderedObject) Len() int {
+	return len(o.members)
+}
+
+// Keys returns the keys of o, in order.
+func (o OrderedObject) Keys() []string {
+	keys := make([]string, len(o.members))
+	for i, m := range o.members {
+		keys[i] = m.key
+	}
+	return keys
+}
+
+// Get returns the value of key, and whether it was found.
+func (o OrderedObject) Get(key string) (any, bool) {
+	if i := o.index(key); i >= 0 {
+		return o.members[i].value, true
+	}
+	return nil, false
+}
+
+// Set sets the value of key.
+// New keys are added at the end, existing keys keep their position.
+func (o *OrderedObject) Set(key string, value any) {
+	if i := o.index(key); i >= 0 {
+		o.members[i].value = value
+	} else {
+		o.members = append(o.members, member{key, value})
+	}
+}
+
+// Delete removes key.
+func (o *OrderedObject) Delete(key string) {
+	if i := o.index(key); i >= 0 {
+		o.members = append(o.members[:i], o.members[i+1:]...)
+	}
+}
+
+func (o OrderedObject) index(key string) int {
+	for i, m := range o.members {
+		if m.key == key {
+			return i
+		}
+	}
+	return -1
+}
+
+// MarshalJSON implements [json.Marshaler].
+func (o OrderedObject) MarshalJSON() ([]byte, error) {
+	buf := []byte{'{'}
+	for i, m := range o.members {
+		if i > 0 {
+			buf = append(buf, ',')
+		}
+		k, err := json.Marshal(m.key)
+		if err != nil {
+			return nil, err
+		}
+		v, err := json.Marshal(m.value)
+		if err != nil {
+			return nil, err
+		}
+		buf = append(buf, k...)
+		buf = append(buf, ':')
+		buf = append(buf, v...)
+	}
+	return append(buf, '}'), nil
+}
+
+// UnmarshalJSON implements [json.Unmarshaler].
+func (o *OrderedObject) UnmarshalJSON(b []byte) error {
+	if isNull(b) {
+		return nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	v, err := decodeOrdered(dec)
+	if err != nil {
+		return unexpectedEOF(err)
+	}
+	if dec.More() {
+		return errTrailingData
+	}
+	obj, ok := v.(*OrderedObject)
+	if !ok {
+		return fmt.Errorf("jason: cannot unmarshal %s into OrderedObject", KindOf(b))
+	}
+	*o = *obj
+	return nil
+}
+
+func decodeOrdered(dec *json.Decoder) (any, error) {
+	t, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	switch t {
+	case json.Delim('{'):
+		obj := &OrderedObject{}
+		index := map[string]int{} // Set would take quadratic time
+		for dec.More() {
+			k, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+			v, err := decodeOrdered(dec)
+			if err != nil {
+				return nil, err
+			}
+			if i, ok := index[k.(string)]; ok {
+				obj.members[i].value = v
+			} else {
+				index[k.(string)] = len(obj.members)
+				obj.members = append(obj.members, member{k.(string), v})
+			}
+		}
+		_, err := dec.Token()
+		return obj, err
+	case json.Delim('['):
+		arr := Array{}
+		for dec.More() {
+			v, err := decodeOrdered(dec)
+			if err != nil {
+				return nil, err
+			}
+			arr = append(arr, v)
+		}
+		_, err := dec.Token()
+		return arr, err
+	}
+	return t, nil
+}
@@ -0,0 +1,72 @@
+package jason
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestOrderedObject(t *testing.T) {
+	var o OrderedObject
+	o.Set("b", 1)
+	o.Set("a", 2)
+	o.Set("c", 3)
+	o.Set("b", 4)
+	o.Delete("a")
+	o.Delete("x")
+
+	if got, want := o.Keys(), []string{"b", "c"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Keys() = %q, want %q", got, want)
+	}
+	if v, ok := o.Get("b"); !ok || v != 4 {
+		t.Errorf("Get(b) = %v, %v, want 4, true", v, ok)
+	}
+	if _, ok := o.Get("a"); ok {
+		t.Error("Get(a) found a deleted key")
+	}
+	if o.Len() != 2 {
+		t.Errorf("Len() = %d, want 2", o.Len())
+	}
+}
+
+func TestOrderedObject_json(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{in: `{}`, want: `{}`},
+		{in: ` {"z": 1.50, "a": {"y": [1, {"x": null}], "b": "<"}} `, want: `{"z":1.50,"a":{"y":[1,{"x":null}],"b":"\u003c"}}`},
+		{in: `{"a": 1, "a": 2}`, want: `{"a":2}`},
+		{in: `[1]`, wantErr: true},
+		{in: `{"a": 1`, wantErr: true},
+		{in: `{} {}`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			var o OrderedObject
+			err := json.Unmarshal([]byte(tt.in), &o)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Unmarshal() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			got, err := json.Marshal(o)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Marshal() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOrderedObject_null(t *testing.T) {
+	o := OrderedObject{}
+	o.Set("a", 1)
+	if err := json.Unmarshal([]byte(`null`), &o); err != nil || o.Len() != 1 {
+		t.Errorf("Unmarshal(null) = %v, %d keys, want it unchanged", err, o.Len())
+	}
+}
@@ -0,0 +1,352 @@
+package jason
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var errTestFailed = errors.New("test failed")
+
+// PatchError is the error returned by ApplyPatch
+// when a patch operation fails.
+type PatchError struct {
+	Index int    // index of the failing operation in the patch
+	Op    string // the failing operation, e.g. "add"
+	Err   error
+}
+
+func (e *PatchError) Error() string {
+	msg := e.Err.Error()
+	if perr, ok := e.Err.(*PathError); ok {
+		// The operation is already reported.
+		msg = perr.Err.Error()
+		if perr.Path != "" {
+			msg = perr.Path + ": " + msg
+		}
+	}
+	if e.Op == "" {
+		return fmt.Sprintf("jason: patch operation %d: %s", e.Index, msg)
+	}
+	return fmt.Sprintf("jason: patch operation %d (%s): %s", e.Index, e.Op, msg)
+}
+
+func (e *PatchError) Unwrap() error { return e.Err }
+
+// operation is a JSON Patch (RFC 6902) operation.
+type operation struct {
+	Op    string   `json:"op"`
+	From  *string  `json:"from,omitempty"`
+	Path  *string  `json:"path"`
+	Value RawValue `json:"value,omitempty"`
+}
+
+// ApplyPatch applies the JSON Patch (RFC 6902) patch to doc,
+// and returns the patched document.
+//
+// Patches are atomic: all operations are parsed before any is applied,
+// and are then applied in order to a working copy of doc.
+// The input document is never modified.
+// The first failure is reported as a [*PatchError];
+// failed "test" operations report the expected and actual values.
+//
+// Example of appending a tag to an array:
+//
+//	jason.ApplyPatch(doc, jason.RawArray{
+//		jason.RawValue(`{"op": "add", "path": "/tags/-", "value": "new"}`),
+//	})
+func ApplyPatch(doc RawValue, patch RawArray) (RawValue, error) {
+	tree, err := decodeTree(doc)
+	if err != nil {
+		return nil, err
+	}
+	if tree, err = applyPatch(tree, patch); err != nil {
+		return nil, err
+	}
+	return json.Marshal(tree)
+}
+
+// ApplyPatchInPlace applies the JSON Patch (RFC 6902) patch
+// to the decoded tree doc, like ApplyPatch.
+//
+// The patch is applied to a copy of *doc, made as if by Clone,
+// which replaces *doc only if all operations succeed.
+//
+// Example of patching a configuration tree:
+//
+//	err := jason.ApplyPatchInPlace(&cfg, patch)
+func ApplyPatchInPlace(doc *any, patch RawArray) error {
+	tree, err := applyPatch(Clone(*doc), patch)
+	if err != nil {
+		return err
+	}
+	*doc = tree
+	return nil
+}
+
+func applyPatch(tree any, patch RawArray) (any, error) {
+	ops := make([]operation, len(patch))
+	for i, raw := range patch {
+		if err := json.Unmarshal(raw, &ops[i]); err != nil {
+			return nil, &PatchError{Index: i, Err: err}
+		}
+	}
+	for i, op := range ops {
+		var err error
+		if tree, err = op.apply(tree); err != nil {
+			return nil, &PatchError{Index: i, Op: op.Op, Err: withOp(op.Op, err)}
+		}
+	}
+	return tree, nil
+}
+
+func (op *operation) apply(doc any) (any, error) {
+	if op.Path == nil {
+		return nil, errors.New(`missing "path"`)
+	}
+	path, err := SplitPointer(*op.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	var from []string
+	switch op.Op {
+	case "move", "copy":
+		if op.From == nil {
+			return nil, errors.New(`missing "from"`)
+		}
+		if from, err = SplitPointer(*op.From); err != nil {
+			return nil, err
+		}
+	}
+
+	var value any
+	switch op.Op {
+	case "add", "replace", "test":
+		if op.Value == nil {
+			return nil, errors.New(`missing "value"`)
+		}
+		if value, err = decodeTree(op.Value); err != nil {
+			return nil, err
+		}
+	}
+
+	switch op.Op {
+	case "add":
+		return addTree(doc, path, value)
+	case "remove":
+		doc, _, err = removeTree(doc, path)
+		return doc, err
+	case "replace":
+		return replaceTree(doc, path, value)
+	case "move":
+		if strings.HasPrefix(*op.Path, *op.From+"/") {
+			return nil, fmt.Errorf("cannot move %s into itself", *op.From)
+		}
+		if doc, value, err = removeTree(doc, from); err != nil {
+			return nil, err
+		}
+		return addTree(doc, path, value)
+	case "copy":
+		if value, err = getTree(doc, from); err != nil {
+			return nil, err
+		}
+		return addTree(doc, path, Clone(value))
+	case "test":
+		v, err := getTree(doc, path)
+		if err != nil {
+			return nil, err
+		}
+		if !equal(v, value) {
+			return nil, pathError(path, fmt.Errorf("%w: expected %s, got %s",
+				errTestFailed, op.Value, From(v)))
+		}
+		return doc, nil
+	}
+	return nil, fmt.Errorf("unknown operation %q", op.Op)
+}
+
+// getTree returns the value at path in doc.
+func getTree(doc any, path []string) (any, error) {
+	for i, tok := range path {
+		switch node := doc.(type) {
+		case Object:
+			v, ok := node[tok]
+			if !ok {
+				return nil, pathError(path[:i+1], ErrNotFound)
+			}
+			doc = v
+		case Array:
+			idx, err := arrayIndex(tok, len(node))
+			if err != nil {
+				return nil, pathError(path[:i+1], err)
+			}
+			doc = node[idx]
+		default:
+			return nil, pathError(path[:i+1], errNotContainer)
+		}
+	}
+	return doc, nil
+}
+
+// addTree adds value at path in doc, inserting into arrays,
+// and returns the updated document.
+func addTree(doc any, path []string, value any) (any, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+	return modifyTree(doc, path, func(node any, tok string) (any, error) {
+		switch node := node.(type) {
+		case Object:
+			node[tok] = value
+			return node, nil
+		case Array:
+			idx := len(node)
+			if tok != "-" {
+				var ok bool
+				idx, ok = parseIndex(tok)
+				if !ok {
+					return nil, errInvalidIndex
+				}
+				if idx > len(node) {
+					return nil, ErrIndexRange
+				}
+			}
+			node = append(node, nil)
+			copy(node[idx+1:], node[idx:])
+			node[idx] = value
+			return node, nil
+		}
+		return nil, errNotContainer
+	})
+}
+
+// removeTree removes the value at path in doc,
+// and returns the updated document and the removed value.
+func removeTree(doc any, path []string) (_, removed any, err error) {
+	if len(path) == 0 {
+		return nil, nil, errors.New("cannot remove the whole document")
+	}
+	doc, err = modifyTree(doc, path, func(node any, tok string) (any, error) {
+		switch node := node.(type) {
+		case Object:
+			v, ok := node[tok]
+			if !ok {
+				return nil, ErrNotFound
+			}
+			delete(node, tok)
+			removed = v
+			return node, nil
+		case Array:
+			idx, err := arrayIndex(tok, len(node))
+			if err != nil {
+				return nil, err
+			}
+			removed = node[idx]
+			return append(node[:idx], node[idx+1:]...), nil
+		}
+		return nil, errNotContainer
+	})
+	return doc, removed, err
+}
+
+// replaceTree replaces the existing value at path in doc,
+// and returns the updated document.
+func replaceTree(doc any, path []string, value any) (any, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+	return modifyTree(doc, path, func(node any, tok string) (any, error) {
+		switch node := node.(type) {
+		case Object:
+			if _, ok := node[tok]; !ok {
+				return nil, ErrNotFound
+			}
+			node[tok] = value
+			return node, nil
+		case Array:
+			idx, err := arrayIndex(tok, len(node))
+			if err != nil {
+				return nil, err
+			}
+			node[idx] = value
+			return node, nil
+		}
+		return nil, errNotContainer
+	})
+}
+
+// modifyTree calls fn with the container holding the last token of path,
+// and returns doc with that container replaced by the result of fn.
+func modifyTree(doc any, path []string, fn func(node any, tok string) (any, error)) (any, error) {
+	var walk func(node any, i int) (any, error)
+	walk = func(node any, i int) (any, error) {
+		tok := path[i]
+		if i == len(path)-1 {
+			node, err := fn(node, tok)
+			if err != nil {
+				return nil, pathError(path, err)
+			}
+			return node, nil
+		}
+
+		switch node := node.(type) {
+		case Object:
+			child, ok := node[tok]
+			if !ok {
+				return nil, pathError(path[:i+1], ErrNotFound)
+			}
+			child, err := walk(child, i+1)
+			if err != nil {
+				return nil, err
+			}
+			node[tok] = child
+			return node, nil
+		case Array:
+			idx, err := arrayIndex(tok, len(node))
+			if err != nil {
+				return nil, pathError(path[:i+1], err)
+			}
+			child, err := walk(node[idx], i+1)
+			if err != nil {
+				return nil, err
+			}
+			node[idx] = child
+			return node, nil
+		}
+		return nil, pathError(path[:i+1], errNotContainer)
+	}
+	return walk(doc, 0)
+}
+
+// arrayIndex parses tok as an index into an array of length n.
+func arrayIndex(tok string, n int) (int, error) {
+	if tok == "-" {
+		return 0, ErrIndexRange
+	}
+	idx, ok := parseIndex(tok)
+	if !ok {
+		return 0, errInvalidIndex
+	}
+	if idx >= n {
+		return 0, ErrIndexRange
+	}
+	return idx, nil
+}
+
+// pathError returns a [*PathError] for path,
+// whose operation is later set by withOp.
+func pathError(path []string, err error) error {
+	return &PathError{Path: JoinPointer(path...), Err: err}
+}
+
+// withOp sets to op the operation of the [*PathError] in err,
+// if it does not have one yet.
+func withOp(op string, err error) error {
+	var perr *PathError
+	if errors.As(err, &perr) && perr.Op == "" {
+		perr.Op = op
+	}
+	return err
+}
@@ -0,0 +1,133 @@
+package jason
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func patchOf(t *testing.T, s string) RawArray {
+	t.Helper()
+	var p RawArray
+	if err := json.Unmarshal([]byte(s), &p); err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+// Tests from RFC 6902, Appendix A.
+func TestApplyPatch(t *testing.T) {
+	tests := []struct {
+		name    string
+		doc     string
+		patch   string
+		want    string
+		wantErr error
+	}{
+		{name: "add member", doc: `{"foo":"bar"}`, patch: `[{"op":"add","path":"/baz","value":"qux"}]`, want: `{"baz":"qux","foo":"bar"}`},
+		{name: "add element", doc: `{"foo":["bar","baz"]}`, patch: `[{"op":"add","path":"/foo/1","value":"qux"}]`, want: `{"foo":["bar","qux","baz"]}`},
+		{name: "remove member", doc: `{"baz":"qux","foo":"bar"}`, patch: `[{"op":"remove","path":"/baz"}]`, want: `{"foo":"bar"}`},
+		{name: "remove element", doc: `{"foo":["bar","qux","baz"]}`, patch: `[{"op":"remove","path":"/foo/1"}]`, want: `{"foo":["bar","baz"]}`},
+		{name: "replace", doc: `{"baz":"qux","foo":"bar"}`, patch: `[{"op":"replace","path":"/baz","value":"boo"}]`, want: `{"baz":"boo","foo":"bar"}`},
+		{name: "move member", doc: `{"foo":{"bar":"baz","waldo":"fred"},"qux":{"corge":"grault"}}`,
+			patch: `[{"op":"move","from":"/foo/waldo","path":"/qux/thud"}]`,
+			want:  `{"foo":{"bar":"baz"},"qux":{"corge":"grault","thud":"fred"}}`},
+		{name: "move element", doc: `{"foo":["all","grass","cows","eat"]}`, patch: `[{"op":"move","from":"/foo/1","path":"/foo/3"}]`,
+			want: `{"foo":["all","cows","eat","grass"]}`},
+		{name: "test", doc: `{"baz":"qux","foo":["a",2,"c"]}`,
+			patch: `[{"op":"test","path":"/baz","value":"qux"},{"op":"test","path":"/foo/1","value":2}]`,
+			want:  `{"baz":"qux","foo":["a",2,"c"]}`},
+		{name: "test fails", doc: `{"baz":"qux"}`, patch: `[{"op":"test","path":"/baz","value":"bar"}]`, wantErr: errTestFailed},
+		{name: "add nested", doc: `{"foo":"bar"}`, patch: `[{"op":"add","path":"/child","value":{"grandchild":{}}}]`,
+			want: `{"foo":"bar","child":{"grandchild":{}}}`},
+		{name: "add to nonexistent", doc: `{"foo":"bar"}`, patch: `[{"op":"add","path":"/baz/bat","value":"qux"}]`, wantErr: ErrNotFound},
+		{name: "escapes", doc: `{"/":9,"~1":10}`, patch: `[{"op":"test","path":"/~01","value":10}]`, want: `{"/":9,"~1":10}`},
+		{name: "test number", doc: `{"/":9,"~1":10}`, patch: `[{"op":"test","path":"/~01","value":"10"}]`, wantErr: errTestFailed},
+		{name: "add array", doc: `{"foo":["bar"]}`, patch: `[{"op":"add","path":"/foo/-","value":["abc","def"]}]`,
+			want: `{"foo":["bar",["abc","def"]]}`},
+		{name: "add null", doc: `{}`, patch: `[{"op":"add","path":"/a","value":null}]`, want: `{"a":null}`},
+		{name: "add root", doc: `{"a":1}`, patch: `[{"op":"add","path":"","value":[1]}]`, want: `[1]`},
+		{name: "copy", doc: `{"a":{"b":1}}`, patch: `[{"op":"copy","from":"/a","path":"/c"},{"op":"replace","path":"/c/b","value":2}]`,
+			want: `{"a":{"b":1},"c":{"b":2}}`},
+		{name: "add past end", doc: `[1]`, patch: `[{"op":"add","path":"/2","value":3}]`, wantErr: ErrIndexRange},
+		{name: "remove missing", doc: `{}`, patch: `[{"op":"remove","path":"/a"}]`, wantErr: ErrNotFound},
+		{name: "replace missing", doc: `[]`, patch: `[{"op":"replace","path":"/0","value":1}]`, wantErr: ErrIndexRange},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ApplyPatch(RawValue(tt.doc), patchOf(t, tt.patch))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ApplyPatch() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && !Equal(got, RawValue(tt.want)) {
+				t.Errorf("ApplyPatch() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyPatch_invalid(t *testing.T) {
+	tests := []string{
+		`[{"op":"add","path":"/a"}]`,
+		`[{"op":"add","value":1}]`,
+		`[{"op":"move","path":"/a"}]`,
+		`[{"op":"move","from":"/a","path":"/a/b"}]`,
+		`[{"op":"frob","path":"/a"}]`,
+		`[{"op":"remove","path":""}]`,
+		`[{"op":"add","path":"a","value":1}]`,
+		`[1]`,
+	}
+	for _, patch := range tests {
+		t.Run(patch, func(t *testing.T) {
+			_, err := ApplyPatch(RawValue(`{"a":{}}`), patchOf(t, patch))
+			var perr *PatchError
+			if !errors.As(err, &perr) || perr.Index != 0 {
+				t.Errorf("ApplyPatch() error = %v, want a PatchError for operation 0", err)
+			}
+		})
+	}
+}
+
+func TestApplyPatchInPlace(t *testing.T) {
+	inner := Array{Number("1")}
+	var doc any = Object{"a": inner, "b": "x"}
+
+	err := ApplyPatchInPlace(&doc, patchOf(t, `[
+		{"op":"add","path":"/a/-","value":2},
+		{"op":"remove","path":"/b"},
+		{"op":"add","path":"/c","value":{"d":null}}
+	]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Object{"a": Array{Number("1"), Number("2")}, "c": Object{"d": nil}}
+	if !reflect.DeepEqual(doc, want) {
+		t.Errorf("ApplyPatchInPlace() = %#v, want %#v", doc, want)
+	}
+	if len(inner) != 1 {
+		t.Errorf("ApplyPatchInPlace() modified the original tree: %v", inner)
+	}
+
+	// A failed patch leaves the document unchanged.
+	before := Clone(doc)
+	err = ApplyPatchInPlace(&doc, patchOf(t, `[
+		{"op":"remove","path":"/a/0"},
+		{"op":"test","path":"/c","value":1}
+	]`))
+	var perr *PatchError
+	if !errors.As(err, &perr) || perr.Index != 1 || !errors.Is(err, errTestFailed) {
+		t.Errorf("ApplyPatchInPlace() error = %v, want a failed test at operation 1", err)
+	}
+	if !reflect.DeepEqual(doc, before) {
+		t.Errorf("ApplyPatchInPlace() = %#v after failing, want %#v", doc, before)
+	}
+
+	var root any
+	if err := ApplyPatchInPlace(&root, patchOf(t, `[{"op":"add","path":"","value":[true]}]`)); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(root, Array{true}) {
+		t.Errorf("ApplyPatchInPlace() = %#v, want [true]", root)
+	}
+}
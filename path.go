@@ -0,0 +1,188 @@
+package jason
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrNull is returned when a path goes through a JSON null.
+var ErrNull = errors.New("null value")
+
+// Get resolves the dot separated path against j,
+// and unmarshals the value found into a value of type T.
+//
+// Object keys are separated by dots, and array indices are
+// enclosed in brackets. A backslash escapes the next character,
+// so that keys can contain dots, brackets or backslashes.
+// The empty path refers to the whole document.
+//
+// Failures to resolve path are reported as a [*PathError].
+// Resolving a path through a JSON null
+// returns the zero value and an error wrapping [ErrNull].
+//
+// Example of getting the name of the first role of a user:
+//
+//	jason.Get[string](j, "user.roles[0].name")
+func Get[T any](j RawValue, path string) (v T, err error) {
+	raw, err := getPath(j, path)
+	if err != nil {
+		return v, err
+	}
+	return AsA[T](raw)
+}
+
+// GetOr is like Get, but returns def if the path is not found,
+// the value found is a JSON null, or it can't be unmarshaled into T.
+//
+// Example of reading an optional setting:
+//
+//	jason.GetOr(cfg, "server.port", 8080)
+func GetOr[T any](j RawValue, path string, def T) T {
+	raw, err := getPath(j, path)
+	if err != nil || isNull(raw) {
+		return def
+	}
+	v, err := AsA[T](raw)
+	if err != nil {
+		return def
+	}
+	return v
+}
+
+// segment is a component of a dot separated path:
+// an object key, or an array index if index >= 0.
+type segment struct {
+	key   string
+	index int
+}
+
+func getPath(j RawValue, path string) (RawValue, error) {
+	segs, err := parsePath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, seg := range segs {
+		if isNull(j) {
+			return nil, pathSegmentsError(segs[:i], ErrNull)
+		}
+		if seg.index < 0 {
+			var obj RawObject
+			if err := json.Unmarshal(j, &obj); err != nil {
+				return nil, pathSegmentsError(segs[:i], typeError(err, ErrNotObject))
+			}
+			v, ok := obj[seg.key]
+			if !ok {
+				return nil, pathSegmentsError(segs[:i+1], ErrNotFound)
+			}
+			j = v
+		} else {
+			var arr RawArray
+			if err := json.Unmarshal(j, &arr); err != nil {
+				return nil, pathSegmentsError(segs[:i], typeError(err, ErrNotArray))
+			}
+			if seg.index >= len(arr) {
+				return nil, pathSegmentsError(segs[:i+1], fmt.Errorf("%w (length %d)", ErrIndexRange, len(arr)))
+			}
+			j = arr[seg.index]
+		}
+	}
+	return j, nil
+}
+
+func pathSegmentsError(segs []segment, err error) error {
+	return &PathError{Path: formatPath(segs), Op: "get", Err: err}
+}
+
+func parsePath(path string) ([]segment, error) {
+	var segs []segment
+	key := path != "" && path[0] != '['
+	for i := 0; i < len(path) || key; {
+		if key {
+			k, n := parseKey(path[i:])
+			segs = append(segs, segment{key: k, index: -1})
+			key = false
+			i += n
+			continue
+		}
+
+		switch path[i] {
+		case '.':
+			key = true
+			i++
+		case '[':
+			end := strings.IndexByte(path[i:], ']')
+			if end < 0 {
+				return nil, fmt.Errorf("jason: invalid path %q: unterminated index", path)
+			}
+			idx, ok := parseIndex(path[i+1 : i+end])
+			if !ok {
+				return nil, fmt.Errorf("jason: invalid path %q: invalid index %q", path, path[i+1:i+end])
+			}
+			segs = append(segs, segment{index: idx})
+			i += end + 1
+		default:
+			return nil, fmt.Errorf("jason: invalid path %q: unexpected %q after index", path, path[i])
+		}
+	}
+	return segs, nil
+}
+
+// parseKey parses a key at the start of s,
+// and returns the unescaped key and the number of bytes consumed.
+func parseKey(s string) (string, int) {
+	var buf strings.Builder
+	i := 0
+	for ; i < len(s); i++ {
+		c := s[i]
+		if c == '.' || c == '[' {
+			break
+		}
+		if c == '\\' && i+1 < len(s) {
+			i++
+			c = s[i]
+		}
+		buf.WriteByte(c)
+	}
+	return buf.String(), i
+}
+
+func formatPath(segs []segment) string {
+	var buf strings.Builder
+	for i, seg := range segs {
+		if seg.index >= 0 {
+			buf.WriteByte('[')
+			buf.WriteString(strconv.Itoa(seg.index))
+			buf.WriteByte(']')
+			continue
+		}
+		if i > 0 {
+			buf.WriteByte('.')
+		}
+		buf.WriteString(escapeKey(seg.key))
+	}
+	return buf.String()
+}
+
+var keyEscaper = strings.NewReplacer(`\`, `\\`, `.`, `\.`, `[`, `\[`)
+
+func escapeKey(key string) string {
+	return keyEscaper.Replace(key)
+}
+
+// typeError replaces a JSON type mismatch in err with sentinel.
+func typeError(err, sentinel error) error {
+	var typ *json.UnmarshalTypeError
+	if errors.As(err, &typ) {
+		return sentinel
+	}
+	return err
+}
+
+func isNull(j RawValue) bool {
+	return string(bytes.TrimSpace(j)) == "null"
+}
@@ -0,0 +1,111 @@
+package jason
+
+import (
+	"errors"
+	"testing"
+)
+
+var pathDoc = RawValue(`{"user": {"roles": [{"name": "admin"}, {"name": "dev"}], "a.b": 1, "x[0]": 2, "n": null}, "list": [[1, 2]]}`)
+
+func TestGet(t *testing.T) {
+	tests := []struct {
+		path    string
+		want    any
+		wantErr error
+	}{
+		{path: "user.roles[0].name", want: "admin"},
+		{path: "user.roles[1].name", want: "dev"},
+		{path: `user.a\.b`, want: 1.0},
+		{path: `user.x\[0]`, want: 2.0},
+		{path: "list[0][1]", want: 2.0},
+		{path: "user.n", want: nil},
+		{path: "user.missing", wantErr: ErrNotFound},
+		{path: "user.roles[2]", wantErr: ErrIndexRange},
+		{path: "user.roles.name", wantErr: ErrNotObject},
+		{path: "user[0]", wantErr: ErrNotArray},
+		{path: "user.n.x", wantErr: ErrNull},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			got, err := Get[any](pathDoc, tt.path)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Get() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Get() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGet_root(t *testing.T) {
+	got, err := Get[map[string]any](pathDoc, "")
+	if err != nil || len(got) != 2 {
+		t.Errorf("Get() = %v, %v, want the whole document", got, err)
+	}
+}
+
+func TestGet_errors(t *testing.T) {
+	tests := []struct {
+		path     string
+		errPath  string
+		wantPath bool
+	}{
+		{path: "user.roles[5].name", errPath: "user.roles[5]", wantPath: true},
+		{path: "user.n.x", errPath: "user.n", wantPath: true},
+		{path: "user.roles.name", errPath: "user.roles", wantPath: true},
+		{path: "user.roles[x]"},
+		{path: "user.roles[0"},
+		{path: "user.roles[0]x"},
+		{path: "user.roles[-1]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			_, err := Get[any](pathDoc, tt.path)
+			if err == nil {
+				t.Fatal("Get() succeeded")
+			}
+			var perr *PathError
+			if ok := errors.As(err, &perr); ok != tt.wantPath || ok && perr.Path != tt.errPath {
+				t.Errorf("Get() error = %v, want a PathError at %q", err, tt.errPath)
+			}
+		})
+	}
+}
+
+func TestParsePath(t *testing.T) {
+	for _, path := range []string{"a", "a.b", "a[0]", "[0].a", `a\.b.c\\`, `a\[b`, "a[0][1].b", "a..b"} {
+		segs, err := parsePath(path)
+		if err != nil {
+			t.Fatalf("parsePath(%q) error = %v", path, err)
+		}
+		if got := formatPath(segs); got != path {
+			t.Errorf("formatPath(parsePath(%q)) = %q", path, got)
+		}
+	}
+}
+
+func TestGetOr(t *testing.T) {
+	tests := []struct {
+		path string
+		def  string
+		want string
+	}{
+		{"user.roles[0].name", "x", "admin"},
+		{"user.missing", "x", "x"},
+		{"user.n", "x", "x"},
+		{"user.a\\.b", "x", "x"},
+		{"user.roles[9].name", "x", "x"},
+		{"user.roles[", "x", "x"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			if got := GetOr(pathDoc, tt.path, tt.def); got != tt.want {
+				t.Errorf("GetOr() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+	if got := GetOr(pathDoc, "user.a\\.b", 0); got != 1 {
+		t.Errorf("GetOr() = %d, want 1", got)
+	}
+}
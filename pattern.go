@@ -0,0 +1,139 @@
+package jason
+
+import "strconv"
+
+// matcher matches paths against a set of patterns:
+// JSON Pointers where a "*" token matches any single key or index,
+// and a "**" token matches any number of them.
+type matcher [][]string
+
+// cursor is a position within one of the patterns of a matcher.
+type cursor struct{ pat, pos int }
+
+func newMatcher(patterns []string) (matcher, error) {
+	m := make(matcher, len(patterns))
+	for i, p := range patterns {
+		tokens, err := SplitPointer(p)
+		if err != nil {
+			return nil, err
+		}
+		m[i] = tokens
+	}
+	return m, nil
+}
+
+// start returns the cursors matching the empty path.
+func (m matcher) start() []cursor {
+	cs := make([]cursor, len(m))
+	for i := range m {
+		cs[i] = cursor{pat: i}
+	}
+	return m.closure(cs)
+}
+
+// step advances cs past the path token tok.
+func (m matcher) step(cs []cursor, tok string) []cursor {
+	var next []cursor
+	for _, c := range cs {
+		p := m[c.pat]
+		if c.pos < len(p) {
+			switch p[c.pos] {
+			case "**":
+				next = append(next, c)
+			case "*", tok:
+				next = append(next, cursor{c.pat, c.pos + 1})
+			}
+		}
+	}
+	return m.closure(next)
+}
+
+// closure adds to cs the cursors reached by "**" matching nothing.
+func (m matcher) closure(cs []cursor) []cursor {
+	for i := 0; i < len(cs); i++ {
+		c := cs[i]
+		if p := m[c.pat]; c.pos < len(p) && p[c.pos] == "**" {
+			n := cursor{c.pat, c.pos + 1}
+			if !containsCursor(cs, n) {
+				cs = append(cs, n)
+			}
+		}
+	}
+	return cs
+}
+
+func containsCursor(cs []cursor, c cursor) bool {
+	for _, e := range cs {
+		if e == c {
+			return true
+		}
+	}
+	return false
+}
+
+// matched reports whether any pattern fully matched.
+func (m matcher) matched(cs []cursor) bool {
+	for _, c := range cs {
+		if c.pos == len(m[c.pat]) {
+			return true
+		}
+	}
+	return false
+}
+
+// replaceTree replaces every value in the decoded tree node
+// that matches, by the result of calling fn on it.
+// The children of replaced values are not visited.
+func (m matcher) replaceTree(node any, cs []cursor, fn func(any) (any, error)) (any, error) {
+	if m.matched(cs) {
+		return fn(node)
+	}
+
+	switch node := node.(type) {
+	case Object:
+		for k, v := range node {
+			if next := m.step(cs, k); next != nil {
+				v, err := m.replaceTree(v, next, fn)
+				if err != nil {
+					return nil, err
+				}
+				node[k] = v
+			}
+		}
+	case Array:
+		for i, v := range node {
+			if next := m.step(cs, strconv.Itoa(i)); next != nil {
+				v, err := m.replaceTree(v, next, fn)
+				if err != nil {
+					return nil, err
+				}
+				node[i] = v
+			}
+		}
+	}
+	return node, nil
+}
+
+// ignoreTree removes from the decoded tree node every object member
+// that matches, and replaces every array element that matches by null.
+func (m matcher) ignoreTree(node any, cs []cursor) any {
+	switch node := node.(type) {
+	case Object:
+		for k, v := range node {
+			if next := m.step(cs, k); m.matched(next) {
+				delete(node, k)
+			} else if next != nil {
+				node[k] = m.ignoreTree(v, next)
+			}
+		}
+	case Array:
+		for i, v := range node {
+			if next := m.step(cs, strconv.Itoa(i)); m.matched(next) {
+				node[i] = nil
+			} else if next != nil {
+				node[i] = m.ignoreTree(v, next)
+			}
+		}
+	}
+	return node
+}
@@ -0,0 +1,280 @@
+package jason
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrNotFound is returned when an object key is missing.
+	ErrNotFound = errors.New("not found")
+	// ErrIndexRange is returned when an array index is out of range.
+	ErrIndexRange = errors.New("index out of range")
+	// ErrNotObject is returned when a value is not an object.
+	ErrNotObject = errors.New("not an object")
+	// ErrNotArray is returned when a value is not an array.
+	ErrNotArray = errors.New("not an array")
+
+	errInvalidIndex = errors.New("invalid array index")
+	errNotContainer = errors.New("not an object or array")
+)
+
+// PathError records an error resolving or editing a path,
+// and the operation and path that caused it.
+//
+// Path is a JSON Pointer, or a dot separated path,
+// to the first value that could not be resolved.
+//
+// Example of reporting where a missing key was expected:
+//
+//	var perr *jason.PathError
+//	_, err := jason.Pointer(j, "/a/b")
+//	if errors.Is(err, jason.ErrNotFound) && errors.As(err, &perr) { ... perr.Path ... }
+type PathError struct {
+	Path string
+	Op   string
+	Err  error
+}
+
+func (e *PathError) Error() string {
+	if e.Path == "" {
+		return "jason: " + e.Op + ": " + e.Err.Error()
+	}
+	return "jason: " + e.Op + " " + e.Path + ": " + e.Err.Error()
+}
+
+func (e *PathError) Unwrap() error { return e.Err }
+
+// Pointer resolves the JSON Pointer (RFC 6901) ptr against j.
+//
+// Objects and arrays are walked token by token,
+// so values not along the path are never materialized.
+// The empty pointer resolves to the whole document.
+// Failures to resolve ptr are reported as a [*PathError].
+//
+// Example of getting the name of the first user:
+//
+//	jason.Pointer(j, "/users/0/name")
+func Pointer(j RawValue, ptr string) (RawValue, error) {
+	dec, err := seekPointer(j, ptr)
+	if err != nil {
+		return nil, err
+	}
+	var v RawValue
+	if err := dec.Decode(&v); err != nil {
+		return nil, unexpectedEOF(err)
+	}
+	return v, nil
+}
+
+// Locate returns the byte offsets in j of the start and end
+// of the value the JSON Pointer ptr resolves to,
+// so that j[start:end] is that value, as written in j.
+//
+// Example of highlighting the source of a validation error:
+//
+//	start, end, err := jason.Locate(j, perr.Path)
+func Locate(j RawValue, ptr string) (start, end int, err error) {
+	dec, err := seekPointer(j, ptr)
+	if err != nil {
+		return 0, 0, err
+	}
+	offset := int(dec.InputOffset())
+	if err := skipValue(dec); err != nil {
+		return 0, 0, unexpectedEOF(err)
+	}
+	end = int(dec.InputOffset())
+	start = end - len(trimValue(j[offset:end]))
+	return start, end, nil
+}
+
+// Exists reports whether the JSON Pointer ptr resolves to a value in j,
+// including a JSON null.
+func Exists(j RawValue, ptr string) bool {
+	dec, err := seekPointer(j, ptr)
+	if err != nil {
+		return false
+	}
+	_, err = dec.Token()
+	return err == nil
+}
+
+// ExistsNonNull reports whether the JSON Pointer ptr resolves
+// to a value in j, other than a JSON null.
+func ExistsNonNull(j RawValue, ptr string) bool {
+	dec, err := seekPointer(j, ptr)
+	if err != nil {
+		return false
+	}
+	t, err := dec.Token()
+	return err == nil && t != nil
+}
+
+// seekPointer returns a decoder for j,
+// positioned at the value the JSON Pointer ptr resolves to.
+func seekPointer(j RawValue, ptr string) (*json.Decoder, error) {
+	tokens, err := SplitPointer(ptr)
+	if err != nil {
+		return nil, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(j))
+	for i, tok := range tokens {
+		t, err := dec.Token()
+		if err != nil {
+			return nil, unexpectedEOF(err)
+		}
+		switch t {
+		case json.Delim('{'):
+			err = seekKey(dec, tok)
+		case json.Delim('['):
+			err = seekIndex(dec, tok)
+		default:
+			err = errNotContainer
+		}
+		if err != nil {
+			return nil, &PathError{Path: JoinPointer(tokens[:i+1]...), Op: "get", Err: unexpectedEOF(err)}
+		}
+	}
+	return dec, nil
+}
+
+// seekKey advances dec, positioned inside an object,
+// to the value of key.
+func seekKey(dec *json.Decoder, key string) error {
+	for dec.More() {
+		t, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		if t == key {
+			return nil
+		}
+		if err := skipValue(dec); err != nil {
+			return err
+		}
+	}
+	return ErrNotFound
+}
+
+// seekIndex advances dec, positioned inside an array,
+// to the element at the index tok.
+func seekIndex(dec *json.Decoder, tok string) error {
+	if tok == "-" {
+		return ErrIndexRange
+	}
+	idx, ok := parseIndex(tok)
+	if !ok {
+		return errInvalidIndex
+	}
+	for i := 0; dec.More(); i++ {
+		if i == idx {
+			return nil
+		}
+		if err := skipValue(dec); err != nil {
+			return err
+		}
+	}
+	return ErrIndexRange
+}
+
+// skipValue consumes the next value from dec.
+func skipValue(dec *json.Decoder) error {
+	depth := 0
+	for {
+		t, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		switch t {
+		case json.Delim('{'), json.Delim('['):
+			depth++
+		case json.Delim('}'), json.Delim(']'):
+			depth--
+		}
+		if depth == 0 {
+			return nil
+		}
+	}
+}
+
+// parseIndex parses an array index token:
+// a decimal integer without leading zeros.
+func parseIndex(tok string) (int, bool) {
+	if tok == "" || tok[0] == '+' || len(tok) > 1 && tok[0] == '0' {
+		return 0, false
+	}
+	i, err := strconv.Atoi(tok)
+	return i, err == nil && i >= 0
+}
+
+// SplitPointer splits the JSON Pointer ptr into its unescaped tokens.
+//
+// Example of splitting a pointer:
+//
+//	jason.SplitPointer("/a~1b/0") // ["a/b" "0"]
+func SplitPointer(ptr string) ([]string, error) {
+	if ptr == "" {
+		return nil, nil
+	}
+	if ptr[0] != '/' {
+		return nil, fmt.Errorf("jason: invalid JSON pointer %q", ptr)
+	}
+	tokens := strings.Split(ptr[1:], "/")
+	for i, tok := range tokens {
+		tokens[i] = UnescapeToken(tok)
+	}
+	return tokens, nil
+}
+
+// JoinPointer escapes and joins tokens into a JSON Pointer.
+//
+// Example of building a pointer from keys containing slashes:
+//
+//	jason.JoinPointer("users", "a/b", "roles") // "/users/a~1b/roles"
+func JoinPointer(tokens ...string) string {
+	var buf strings.Builder
+	for _, tok := range tokens {
+		buf.WriteByte('/')
+		buf.WriteString(EscapeToken(tok))
+	}
+	return buf.String()
+}
+
+var (
+	tokenEscaper   = strings.NewReplacer("~", "~0", "/", "~1")
+	tokenUnescaper = strings.NewReplacer("~1", "/", "~0", "~")
+)
+
+// EscapeToken escapes s for use as a JSON Pointer token,
+// replacing "~" with "~0" and "/" with "~1".
+func EscapeToken(s string) string {
+	return tokenEscaper.Replace(s)
+}
+
+// UnescapeToken reverses EscapeToken,
+// replacing "~1" with "/" and "~0" with "~".
+func UnescapeToken(s string) string {
+	return tokenUnescaper.Replace(s)
+}
+
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+// isMissing reports whether err, returned by Pointer,
+// means that the pointer did not resolve to a value.
+func isMissing(err error) bool {
+	return errors.Is(err, ErrNotFound) ||
+		errors.Is(err, ErrIndexRange) ||
+		errors.Is(err, errInvalidIndex) ||
+		errors.Is(err, errNotContainer)
+}
@@ -0,0 +1,196 @@
+package jason
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+var pointerDoc = RawValue(`{"users": [{"name": "Alice", "tags": []}, {"name": "Bob"}], "a/b": 1, "m~n": 2, "": 3, "n": null}`)
+
+func TestPointer(t *testing.T) {
+	tests := []struct {
+		ptr     string
+		want    string
+		wantErr error
+	}{
+		{ptr: "", want: string(pointerDoc)},
+		{ptr: "/users/0/name", want: `"Alice"`},
+		{ptr: "/users/1", want: `{"name": "Bob"}`},
+		{ptr: "/users/0/tags", want: `[]`},
+		{ptr: "/a~1b", want: `1`},
+		{ptr: "/m~0n", want: `2`},
+		{ptr: "/", want: `3`},
+		{ptr: "/n", want: `null`},
+		{ptr: "/missing", wantErr: ErrNotFound},
+		{ptr: "/users/2", wantErr: ErrIndexRange},
+		{ptr: "/users/-", wantErr: ErrIndexRange},
+		{ptr: "/users/01", wantErr: errInvalidIndex},
+		{ptr: "/users/x", wantErr: errInvalidIndex},
+		{ptr: "/a~1b/c", wantErr: errNotContainer},
+		{ptr: "/n/x", wantErr: errNotContainer},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ptr, func(t *testing.T) {
+			got, err := Pointer(pointerDoc, tt.ptr)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Pointer() error = %v, want %v", err, tt.wantErr)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Pointer() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPointer_errors(t *testing.T) {
+	tests := []struct {
+		doc  string
+		ptr  string
+		path string
+	}{
+		{doc: `{"a": {"b": 1}}`, ptr: "/a/c", path: "/a/c"},
+		{doc: `[[1]]`, ptr: "/0/1", path: "/0/1"},
+		{doc: `{"a": 1}`, ptr: "/a/b/c", path: "/a/b"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ptr, func(t *testing.T) {
+			_, err := Pointer(RawValue(tt.doc), tt.ptr)
+			var perr *PathError
+			if !errors.As(err, &perr) || perr.Path != tt.path || perr.Op != "get" {
+				t.Errorf("Pointer() error = %v, want a get PathError at %s", err, tt.path)
+			}
+		})
+	}
+	for _, doc := range []string{``, `{"a": `, `{"a" 1}`} {
+		if _, err := Pointer(RawValue(doc), "/a"); err == nil {
+			t.Errorf("Pointer(%q) succeeded", doc)
+		}
+	}
+	if _, err := Pointer(pointerDoc, "users"); err == nil {
+		t.Error("Pointer() accepted a pointer without a leading slash")
+	}
+}
+
+func TestExists(t *testing.T) {
+	tests := []struct {
+		ptr             string
+		exists, nonNull bool
+	}{
+		{"", true, true},
+		{"/users/0/name", true, true},
+		{"/n", true, false},
+		{"/missing", false, false},
+		{"/users/9", false, false},
+		{"/n/x", false, false},
+		{"n", false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ptr, func(t *testing.T) {
+			if got := Exists(pointerDoc, tt.ptr); got != tt.exists {
+				t.Errorf("Exists() = %v, want %v", got, tt.exists)
+			}
+			if got := ExistsNonNull(pointerDoc, tt.ptr); got != tt.nonNull {
+				t.Errorf("ExistsNonNull() = %v, want %v", got, tt.nonNull)
+			}
+		})
+	}
+}
+
+func TestSplitPointer(t *testing.T) {
+	tests := []struct {
+		ptr  string
+		want []string
+	}{
+		{"", nil},
+		{"/", []string{""}},
+		{"/a/0", []string{"a", "0"}},
+		{"/a~1b/m~0n", []string{"a/b", "m~n"}},
+		{"/~01", []string{"~1"}},
+		{"//", []string{"", ""}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ptr, func(t *testing.T) {
+			got, err := SplitPointer(tt.ptr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SplitPointer() = %q, want %q", got, tt.want)
+			}
+			if tt.ptr != "" && JoinPointer(got...) != tt.ptr {
+				t.Errorf("JoinPointer() = %q, want %q", JoinPointer(got...), tt.ptr)
+			}
+		})
+	}
+	if _, err := SplitPointer("a/b"); err == nil {
+		t.Error("SplitPointer() accepted a pointer without a leading slash")
+	}
+}
+
+func TestJoinPointer(t *testing.T) {
+	if got := JoinPointer(); got != "" {
+		t.Errorf("JoinPointer() = %q, want empty", got)
+	}
+	if got, want := JoinPointer("users", "a/b", "~roles"), "/users/a~1b/~0roles"; got != want {
+		t.Errorf("JoinPointer() = %q, want %q", got, want)
+	}
+}
+
+func TestEscapeToken(t *testing.T) {
+	tests := []struct{ in, want string }{
+		{"", ""},
+		{"abc", "abc"},
+		{"a/b", "a~1b"},
+		{"m~n", "m~0n"},
+		{"~1", "~01"},
+		{"/~", "~1~0"},
+	}
+	for _, tt := range tests {
+		if got := EscapeToken(tt.in); got != tt.want {
+			t.Errorf("EscapeToken(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+		if got := UnescapeToken(tt.want); got != tt.in {
+			t.Errorf("UnescapeToken(%q) = %q, want %q", tt.want, got, tt.in)
+		}
+	}
+}
+
+func TestLocate(t *testing.T) {
+	doc := string(pointerDoc)
+	tests := []struct {
+		ptr  string
+		want string
+	}{
+		{"", doc},
+		{"/users", `[{"name": "Alice", "tags": []}, {"name": "Bob"}]`},
+		{"/users/0/name", `"Alice"`},
+		{"/users/0/tags", `[]`},
+		{"/users/1", `{"name": "Bob"}`},
+		{"/a~1b", `1`},
+		{"/", `3`},
+		{"/n", `null`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ptr, func(t *testing.T) {
+			start, end, err := Locate(pointerDoc, tt.ptr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := doc[start:end]; got != tt.want {
+				t.Errorf("Locate() = %d, %d (%s), want %s", start, end, got, tt.want)
+			}
+		})
+	}
+
+	start, end, err := Locate(RawValue(" \n[ 1 ,\t2 ] "), "/1")
+	if err != nil || start != 8 || end != 9 {
+		t.Errorf("Locate() = %d, %d, %v, want 8, 9", start, end, err)
+	}
+	if _, _, err := Locate(pointerDoc, "/missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Locate() error = %v, want ErrNotFound", err)
+	}
+	if _, _, err := Locate(RawValue(`{"a": [1,`), "/a"); err == nil {
+		t.Error("Locate() accepted invalid JSON")
+	}
+}
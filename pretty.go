@@ -0,0 +1,151 @@
+package jason
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"unicode/utf8"
+)
+
+// PrettyAligned returns an indented form of j, like Indent,
+// but with the colons of the keys of each object aligned vertically,
+// and arrays of scalars kept on one line if they fit in 80 columns.
+//
+// Keys keep their order, and strings are not HTML escaped, as by FromUnescaped.
+// The result is meant for human readers: it is valid JSON,
+// but its layout may change between versions.
+//
+// Example of printing a configuration in a CLI:
+//
+//	out, err := jason.PrettyAligned(cfg, "  ")
+func PrettyAligned(j RawValue, indent string) (RawValue, error) {
+	return PrettyAlignedWidth(j, indent, 80)
+}
+
+// PrettyAlignedWidth is like PrettyAligned, but keeps arrays of scalars
+// on one line if they fit in width columns, counted in runes.
+// A width of zero or less puts every array element on its own line.
+func PrettyAlignedWidth(j RawValue, indent string, width int) (RawValue, error) {
+	if err := Validate(j); err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(j))
+	dec.UseNumber()
+	v, err := decodeOrdered(dec)
+	if err != nil {
+		return nil, err
+	}
+
+	p := aligner{indent: indent, width: width}
+	if err := p.value(v, 0, 0); err != nil {
+		return nil, err
+	}
+	return p.buf, nil
+}
+
+type aligner struct {
+	buf    []byte
+	indent string
+	width  int
+}
+
+// value appends v, nested depth levels deep, starting at column col.
+func (p *aligner) value(v any, depth, col int) error {
+	switch v := v.(type) {
+	case *OrderedObject:
+		if v.Len() == 0 {
+			p.buf = append(p.buf, "{}"...)
+			return nil
+		}
+
+		keys := make([][]byte, v.Len())
+		pad := 0
+		for i, m := range v.members {
+			k, err := FromUnescaped(m.key)
+			if err != nil {
+				return err
+			}
+			keys[i] = k
+			pad = max(pad, utf8.RuneCount(k))
+		}
+
+		col = utf8.RuneCountInString(p.indent)*(depth+1) + pad + 2
+		p.buf = append(p.buf, '{')
+		for i, m := range v.members {
+			if i > 0 {
+				p.buf = append(p.buf, ',')
+			}
+			p.newline(depth + 1)
+			p.buf = append(p.buf, keys[i]...)
+			p.buf = append(p.buf, ':')
+			p.buf = append(p.buf, strings.Repeat(" ", pad-utf8.RuneCount(keys[i])+1)...)
+			if err := p.value(m.value, depth+1, col); err != nil {
+				return err
+			}
+		}
+		p.newline(depth)
+		p.buf = append(p.buf, '}')
+
+	case Array:
+		if len(v) == 0 {
+			p.buf = append(p.buf, "[]"...)
+			return nil
+		}
+		if line, ok := p.inline(v); ok && col+utf8.RuneCount(line) <= p.width {
+			p.buf = append(p.buf, line...)
+			return nil
+		}
+
+		col = utf8.RuneCountInString(p.indent) * (depth + 1)
+		p.buf = append(p.buf, '[')
+		for i, e := range v {
+			if i > 0 {
+				p.buf = append(p.buf, ',')
+			}
+			p.newline(depth + 1)
+			if err := p.value(e, depth+1, col); err != nil {
+				return err
+			}
+		}
+		p.newline(depth)
+		p.buf = append(p.buf, ']')
+
+	default:
+		j, err := FromUnescaped(v)
+		if err != nil {
+			return err
+		}
+		p.buf = append(p.buf, j...)
+	}
+	return nil
+}
+
+// inline formats an array of scalars on one line.
+func (p *aligner) inline(arr Array) ([]byte, bool) {
+	if p.width <= 0 {
+		return nil, false
+	}
+	line := []byte{'['}
+	for i, e := range arr {
+		switch e.(type) {
+		case *OrderedObject, Array:
+			return nil, false
+		}
+		j, err := FromUnescaped(e)
+		if err != nil {
+			return nil, false
+		}
+		if i > 0 {
+			line = append(line, ", "...)
+		}
+		line = append(line, j...)
+	}
+	return append(line, ']'), true
+}
+
+func (p *aligner) newline(depth int) {
+	p.buf = append(p.buf, '\n')
+	for range depth {
+		p.buf = append(p.buf, p.indent...)
+	}
+}
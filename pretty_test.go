@@ -0,0 +1,43 @@
+package jason
+
+import "testing"
+
+func TestPrettyAligned(t *testing.T) {
+	tests := []struct {
+		name  string
+		in    string
+		width int
+		want  string
+	}{
+		{"scalar", `1`, 80, `1`},
+		{"empty", `{"a":{},"b":[]}`, 80, "{\n  \"a\": {},\n  \"b\": []\n}"},
+		{"aligned", `{"a":1,"bbb":"<x>","cc":null}`, 80,
+			"{\n  \"a\":   1,\n  \"bbb\": \"<x>\",\n  \"cc\":  null\n}"},
+		{"order", `{"z":1,"a":2}`, 80, "{\n  \"z\": 1,\n  \"a\": 2\n}"},
+		{"inline", `{"a":[1,"x",true]}`, 80, "{\n  \"a\": [1, \"x\", true]\n}"},
+		{"too wide", `{"a":[1,2,3]}`, 15, "{\n  \"a\": [\n    1,\n    2,\n    3\n  ]\n}"},
+		{"fits", `{"a":[1,2,3]}`, 16, "{\n  \"a\": [1, 2, 3]\n}"},
+		{"no inline", `[1]`, 0, "[\n  1\n]"},
+		{"nested", `[[1],{"é":2}]`, 80, "[\n  [1],\n  {\n    \"é\": 2\n  }\n]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PrettyAlignedWidth(RawValue(tt.in), "  ", tt.width)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("PrettyAlignedWidth() = %q, want %q", got, tt.want)
+			}
+			if !Equal(got, RawValue(tt.in)) {
+				t.Errorf("PrettyAlignedWidth() = %s, not equal to the input", got)
+			}
+		})
+	}
+}
+
+func TestPrettyAligned_invalid(t *testing.T) {
+	if _, err := PrettyAligned(RawValue(`{"a":`), "  "); err == nil {
+		t.Error("PrettyAligned() accepted invalid JSON")
+	}
+}
@@ -0,0 +1,110 @@
+package jason
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// MarshalProperties encodes o in a line oriented,
+// properties-like format, as flattened by Flatten.
+//
+// Each line has the form key=value, where key is a dot separated path,
+// as accepted by Get, and value is the JSON encoding of a scalar,
+// or of an empty object or array.
+// In keys, backslashes, equals signs, carriage returns and newlines
+// are escaped as \\, \=, \r and \n, in addition to the escapes of the path,
+// and a leading # or ! is escaped as \# or \!, so it doesn't start a comment.
+// Values need no escaping, as JSON encodes newlines as \n.
+// Lines are sorted by key.
+//
+// Example of the encoding of {"a":{"b":[1,"x"]},"c":{}}:
+//
+//	a.b[0]=1
+//	a.b[1]="x"
+//	c={}
+func MarshalProperties(o Object) ([]byte, error) {
+	flat := Flatten(o)
+	keys := make([]string, 0, len(flat))
+	for k := range flat {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var buf bytes.Buffer
+	for _, k := range keys {
+		v, err := json.Marshal(flat[k])
+		if err != nil {
+			return nil, fmt.Errorf("jason: key %q: %w", k, err)
+		}
+		k = propertyEscaper.Replace(k)
+		if k != "" && (k[0] == '#' || k[0] == '!') {
+			buf.WriteByte('\\')
+		}
+		buf.WriteString(k)
+		buf.WriteByte('=')
+		buf.Write(v)
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalProperties decodes the format written by MarshalProperties,
+// reversing it with Unflatten.
+//
+// Empty lines, and lines starting with # or !, are ignored.
+// Values are decoded as by AsTree.
+func UnmarshalProperties(b []byte) (Object, error) {
+	flat := map[string]any{}
+	for n, line := range strings.Split(string(b), "\n") {
+		line = strings.TrimSuffix(line, "\r")
+		if line == "" || line[0] == '#' || line[0] == '!' {
+			continue
+		}
+
+		eq := -1
+		for i := 0; i < len(line); i++ {
+			if line[i] == '\\' {
+				i++
+			} else if line[i] == '=' {
+				eq = i
+				break
+			}
+		}
+		if eq < 0 {
+			return nil, fmt.Errorf("jason: line %d: missing =", n+1)
+		}
+
+		v, err := decodeTree(RawValue(line[eq+1:]))
+		if err != nil {
+			return nil, fmt.Errorf("jason: line %d: %w", n+1, err)
+		}
+		flat[unescapeProperty(line[:eq])] = v
+	}
+	return Unflatten(flat), nil
+}
+
+var propertyEscaper = strings.NewReplacer(`\`, `\\`, `=`, `\=`, "\r", `\r`, "\n", `\n`)
+
+func unescapeProperty(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+	var buf strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c == '\\' && i+1 < len(s) {
+			i++
+			switch c = s[i]; c {
+			case 'r':
+				c = '\r'
+			case 'n':
+				c = '\n'
+			}
+		}
+		buf.WriteByte(c)
+	}
+	return buf.String()
+}
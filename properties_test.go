@@ -0,0 +1,71 @@
+package jason
+
+import "testing"
+
+func TestMarshalProperties(t *testing.T) {
+	tests := []struct {
+		name string
+		in   Object
+		want string
+	}{
+		{"scalars", Object{"a": Object{"b": Array{1, "x"}}, "c": Object{}}, "a.b[0]=1\na.b[1]=\"x\"\nc={}\n"},
+		{"escapes", Object{"a=b": "x\ny", "c\nd": true}, "a\\=b=\"x\\ny\"\nc\\nd=true\n"},
+		{"comments", Object{"#a": 1, "!b": 2, "c#": 3}, "\\!b=2\n\\#a=1\nc#=3\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := MarshalProperties(tt.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(b) != tt.want {
+				t.Errorf("MarshalProperties() = %q, want %q", b, tt.want)
+			}
+			o, err := UnmarshalProperties(b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !equal(o, tt.in) {
+				t.Errorf("UnmarshalProperties() = %v, want %v", o, tt.in)
+			}
+		})
+	}
+}
+
+func TestUnmarshalProperties(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Object
+		wantErr bool
+	}{
+		{in: "# comment\n! comment\n\na=1\r\n", want: Object{"a": Number("1")}},
+		{in: "a.b=null", want: Object{"a": Object{"b": nil}}},
+		{in: "a", wantErr: true},
+		{in: "a=x", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := UnmarshalProperties([]byte(tt.in))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("UnmarshalProperties() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !equal(got, tt.want) {
+				t.Errorf("UnmarshalProperties() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMarshalProperties_empty(t *testing.T) {
+	b, err := MarshalProperties(Object{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	o, err := UnmarshalProperties(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(o) != 0 {
+		t.Errorf("got %v, want an empty object", o)
+	}
+}
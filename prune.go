@@ -0,0 +1,68 @@
+package jason
+
+import "encoding/json"
+
+// PruneOptions sets which values Prune removes, in addition to nulls.
+type PruneOptions struct {
+	EmptyStrings bool // remove empty strings
+	EmptyArrays  bool // remove empty arrays
+	EmptyObjects bool // remove empty objects
+	// Cascade removes objects and arrays that become empty
+	// by pruning, if empty objects or arrays are removed.
+	Cascade bool
+}
+
+// Prune returns a copy of j without the object keys whose values are null,
+// or are empty, as set by opts, at any level.
+//
+// Array elements are pruned recursively, but never removed,
+// so that array indices are preserved.
+//
+// Example of dropping null and empty fields before storage:
+//
+//	jason.Prune(j, jason.PruneOptions{EmptyObjects: true, Cascade: true})
+func Prune(j RawValue, opts PruneOptions) (RawValue, error) {
+	tree, err := decodeTree(j)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(pruneTree(tree, &opts))
+}
+
+func pruneTree(v any, opts *PruneOptions) any {
+	switch v := v.(type) {
+	case Object:
+		res := make(Object, len(v))
+		for k, e := range v {
+			p := pruneTree(e, opts)
+			if opts.Cascade {
+				e = p
+			}
+			if !opts.empty(e) {
+				res[k] = p
+			}
+		}
+		return res
+	case Array:
+		res := make(Array, len(v))
+		for i, e := range v {
+			res[i] = pruneTree(e, opts)
+		}
+		return res
+	}
+	return v
+}
+
+func (opts *PruneOptions) empty(v any) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case string:
+		return opts.EmptyStrings && v == ""
+	case Array:
+		return opts.EmptyArrays && len(v) == 0
+	case Object:
+		return opts.EmptyObjects && len(v) == 0
+	}
+	return false
+}
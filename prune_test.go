@@ -0,0 +1,45 @@
+package jason
+
+import "testing"
+
+func TestPrune(t *testing.T) {
+	doc := RawValue(`{"a":null,"b":"","c":[],"d":{},"e":{"f":null,"g":{"h":{}}},"i":[null,{"j":null},""],"k":0,"l":false}`)
+	tests := []struct {
+		name string
+		opts PruneOptions
+		want string
+	}{
+		{"nulls", PruneOptions{},
+			`{"b":"","c":[],"d":{},"e":{"g":{"h":{}}},"i":[null,{},""],"k":0,"l":false}`},
+		{"strings", PruneOptions{EmptyStrings: true},
+			`{"c":[],"d":{},"e":{"g":{"h":{}}},"i":[null,{},""],"k":0,"l":false}`},
+		{"arrays", PruneOptions{EmptyArrays: true},
+			`{"b":"","d":{},"e":{"g":{"h":{}}},"i":[null,{},""],"k":0,"l":false}`},
+		{"objects", PruneOptions{EmptyObjects: true},
+			`{"b":"","c":[],"e":{"g":{}},"i":[null,{},""],"k":0,"l":false}`},
+		{"cascade", PruneOptions{EmptyObjects: true, Cascade: true},
+			`{"b":"","c":[],"i":[null,{},""],"k":0,"l":false}`},
+		{"cascade arrays", PruneOptions{EmptyArrays: true, Cascade: true},
+			`{"b":"","d":{},"e":{"g":{"h":{}}},"i":[null,{},""],"k":0,"l":false}`},
+		{"everything", PruneOptions{EmptyStrings: true, EmptyArrays: true, EmptyObjects: true, Cascade: true},
+			`{"i":[null,{},""],"k":0,"l":false}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Prune(doc, tt.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !Equal(got, RawValue(tt.want)) {
+				t.Errorf("Prune() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	if got, err := Prune(RawValue(`null`), PruneOptions{}); err != nil || string(got) != `null` {
+		t.Errorf("Prune(null) = %s, %v, want null", got, err)
+	}
+	if _, err := Prune(RawValue(`{"a":`), PruneOptions{}); err == nil {
+		t.Error("Prune() accepted invalid JSON")
+	}
+}
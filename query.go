@@ -0,0 +1,138 @@
+package jason
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"strconv"
+)
+
+// Query returns every value in j whose path matches pattern,
+// in document order.
+//
+// The pattern is a JSON Pointer where a "*" token
+// matches any single object key or array index,
+// and a "**" token matches any number of them.
+// Matches nested in other matches are also returned.
+//
+// Example of collecting the IDs of all items,
+// and every name anywhere in the document:
+//
+//	jason.Query(j, "/items/*/id")
+//	jason.Query(j, "/**/name")
+func Query(j RawValue, pattern string) ([]RawValue, error) {
+	m, err := newMatcher([]string{pattern})
+	if err != nil {
+		return nil, err
+	}
+	q := query{m: m, data: j, dec: json.NewDecoder(bytes.NewReader(j))}
+	if err := q.value(m.start()); err != nil {
+		return nil, unexpectedEOF(err)
+	}
+	if _, err := q.dec.Token(); err != io.EOF {
+		if err == nil {
+			err = errTrailingData
+		}
+		return nil, err
+	}
+	return q.results, nil
+}
+
+// QueryOne returns the first value in j whose path matches pattern,
+// in document order, and whether one was found.
+//
+// The pattern is as for Query.
+// Decoding stops as soon as the first match is complete,
+// so malformed input after it is not reported.
+//
+// Example of finding the first email anywhere in a document:
+//
+//	email, ok, err := jason.QueryOne(j, "/**/email")
+func QueryOne(j RawValue, pattern string) (RawValue, bool, error) {
+	m, err := newMatcher([]string{pattern})
+	if err != nil {
+		return nil, false, err
+	}
+	q := query{m: m, data: j, dec: json.NewDecoder(bytes.NewReader(j)), one: true}
+	switch err := q.value(m.start()); err {
+	case errQueryDone:
+		return q.results[0], true, nil
+	case nil:
+		if _, err := q.dec.Token(); err != io.EOF {
+			if err == nil {
+				err = errTrailingData
+			}
+			return nil, false, err
+		}
+		return nil, false, nil
+	default:
+		return nil, false, unexpectedEOF(err)
+	}
+}
+
+var errQueryDone = errors.New("query done")
+
+type query struct {
+	m       matcher
+	data    []byte
+	dec     *json.Decoder
+	results []RawValue
+	one     bool // stop after the first result
+}
+
+func (q *query) value(cs []cursor) error {
+	start := q.dec.InputOffset()
+	matched := q.m.matched(cs)
+	idx := len(q.results)
+	if matched {
+		q.results = append(q.results, nil)
+	}
+
+	t, err := q.dec.Token()
+	if err != nil {
+		return err
+	}
+	switch t {
+	case json.Delim('{'):
+		for q.dec.More() {
+			k, err := q.dec.Token()
+			if err != nil {
+				return err
+			}
+			if err := q.child(q.m.step(cs, k.(string))); err != nil {
+				return err
+			}
+		}
+		_, err = q.dec.Token()
+	case json.Delim('['):
+		for i := 0; q.dec.More(); i++ {
+			if err := q.child(q.m.step(cs, strconv.Itoa(i))); err != nil {
+				return err
+			}
+		}
+		_, err = q.dec.Token()
+	}
+
+	if matched {
+		raw := q.data[start:q.dec.InputOffset()]
+		q.results[idx] = append(RawValue(nil), trimValue(raw)...)
+		if q.one && idx == 0 && err == nil {
+			return errQueryDone
+		}
+	}
+	return err
+}
+
+func (q *query) child(cs []cursor) error {
+	if cs == nil {
+		return skipValue(q.dec)
+	}
+	return q.value(cs)
+}
+
+// trimValue removes the whitespace and separators
+// that precede a value in its encoding.
+func trimValue(raw []byte) []byte {
+	return bytes.TrimLeft(raw, " \t\r\n,:")
+}
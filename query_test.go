@@ -0,0 +1,90 @@
+package jason
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestQuery(t *testing.T) {
+	doc := RawValue(`{"items": [{"id": 1, "name": "a"}, {"id": 2, "tags": {"name": "b"}}], "name": {"name": "c"}}`)
+	tests := []struct {
+		pattern string
+		want    []string
+	}{
+		{"/items/*/id", []string{`1`, `2`}},
+		{"/items/1", []string{`{"id": 2, "tags": {"name": "b"}}`}},
+		{"/items/*/name", []string{`"a"`}},
+		{"/**/name", []string{`"a"`, `"b"`, `{"name": "c"}`, `"c"`}},
+		{"/*", []string{`[{"id": 1, "name": "a"}, {"id": 2, "tags": {"name": "b"}}]`, `{"name": "c"}`}},
+		{"", []string{string(doc)}},
+		{"/missing", nil},
+		{"/items/2", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pattern, func(t *testing.T) {
+			got, err := Query(doc, tt.pattern)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var want []RawValue
+			for _, w := range tt.want {
+				want = append(want, RawValue(w))
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("Query() = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestQuery_errors(t *testing.T) {
+	for _, in := range []string{`{"a":`, `{"a":1} 2`, `[1,]`, ``} {
+		if _, err := Query(RawValue(in), "/a"); err == nil {
+			t.Errorf("Query(%q) accepted invalid JSON", in)
+		}
+	}
+	if _, err := Query(RawValue(`{}`), "a"); err == nil {
+		t.Error("Query() accepted an invalid pattern")
+	}
+}
+
+func TestQueryOne(t *testing.T) {
+	doc := RawValue(`{"items": [{"id": 1, "name": "a"}, {"id": 2, "tags": {"name": "b"}}], "name": {"name": "c"}}`)
+	tests := []struct {
+		pattern string
+		want    string
+		ok      bool
+	}{
+		{"/items/*/id", `1`, true},
+		{"/items/1/tags", `{"name": "b"}`, true},
+		{"/**/name", `"a"`, true},
+		{"/name/**", `{"name": "c"}`, true},
+		{"", string(doc), true},
+		{"/missing", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pattern, func(t *testing.T) {
+			got, ok, err := QueryOne(doc, tt.pattern)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ok != tt.ok || string(got) != tt.want {
+				t.Errorf("QueryOne() = %s, %v, want %s, %v", got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+
+	// Decoding stops after the first match.
+	got, ok, err := QueryOne(RawValue(`[{"a": 1}, {"a": 2}, oops`), "/*/a")
+	if err != nil || !ok || string(got) != `1` {
+		t.Errorf("QueryOne() = %s, %v, %v, want 1", got, ok, err)
+	}
+	for _, in := range []string{`{"b": [}`, `{"b": 1} 2`, `{"b": 1`} {
+		if _, _, err := QueryOne(RawValue(in), "/a"); err == nil {
+			t.Errorf("QueryOne(%q) accepted invalid JSON", in)
+		}
+	}
+	if _, _, err := QueryOne(doc, "a"); err == nil {
+		t.Error("QueryOne() accepted an invalid pattern")
+	}
+}
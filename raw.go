@@ -0,0 +1,148 @@
+package jason
+
+import (
+	"fmt"
+	"iter"
+	"sort"
+)
+
+// Keys returns the keys of o, in sorted order.
+func Keys(o RawObject) []string {
+	keys := make([]string, 0, len(o))
+	for k := range o {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Values returns the values of o, in the sorted order of their keys.
+func Values(o RawObject) []RawValue {
+	values := make([]RawValue, 0, len(o))
+	for _, k := range Keys(o) {
+		values = append(values, o[k])
+	}
+	return values
+}
+
+// MapValues returns a new RawObject with the same keys as o,
+// and values transformed by fn.
+//
+// Example of compacting every value of an object:
+//
+//	jason.MapValues(o, jason.Compact)
+func MapValues(o RawObject, fn func(RawValue) (RawValue, error)) (RawObject, error) {
+	res := make(RawObject, len(o))
+	for k, v := range o {
+		v, err := fn(v)
+		if err != nil {
+			return nil, fmt.Errorf("jason: key %q: %w", k, err)
+		}
+		res[k] = v
+	}
+	return res, nil
+}
+
+// Pick returns a new RawObject with only those keys of o
+// that are listed in keys.
+//
+// Example of selecting a few fields of a response:
+//
+//	jason.Pick(user, "id", "name")
+func Pick(o RawObject, keys ...string) RawObject {
+	res := make(RawObject, len(keys))
+	for _, k := range keys {
+		if v, ok := o[k]; ok {
+			res[k] = v
+		}
+	}
+	return res
+}
+
+// Omit returns a new RawObject with all keys of o
+// except those listed in keys.
+//
+// Example of removing internal fields from a response:
+//
+//	jason.Omit(user, "password", "salt")
+func Omit(o RawObject, keys ...string) RawObject {
+	res := make(RawObject, len(o))
+	for k, v := range o {
+		res[k] = v
+	}
+	for _, k := range keys {
+		delete(res, k)
+	}
+	return res
+}
+
+// Entries returns an iterator over the key/value pairs of o,
+// in unspecified order, like ranging over o.
+func Entries(o RawObject) iter.Seq2[string, RawValue] {
+	return func(yield func(string, RawValue) bool) {
+		for k, v := range o {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// SortedEntries returns an iterator over the key/value pairs of o,
+// in the sorted order of their keys.
+//
+// Example of printing an object deterministically:
+//
+//	for k, v := range jason.SortedEntries(o) {
+//		fmt.Printf("%s=%s\n", k, v)
+//	}
+func SortedEntries(o RawObject) iter.Seq2[string, RawValue] {
+	return func(yield func(string, RawValue) bool) {
+		for _, k := range Keys(o) {
+			if !yield(k, o[k]) {
+				return
+			}
+		}
+	}
+}
+
+// IndexedEntries returns an iterator over the index/value pairs of arr.
+func IndexedEntries(arr RawArray) iter.Seq2[int, RawValue] {
+	return func(yield func(int, RawValue) bool) {
+		for i, v := range arr {
+			if !yield(i, v) {
+				return
+			}
+		}
+	}
+}
+
+// Materialize decodes every value of o into a tree, as by AsTree.
+//
+// Example of switching from a lazy to an eager representation:
+//
+//	obj, err := jason.Materialize(raw)
+func Materialize(o RawObject) (Object, error) {
+	res := make(Object, len(o))
+	for k, v := range o {
+		t, err := decodeTree(v)
+		if err != nil {
+			return nil, fmt.Errorf("jason: key %q: %w", k, err)
+		}
+		res[k] = t
+	}
+	return res, nil
+}
+
+// Rawify marshals every value of o, reversing Materialize.
+func Rawify(o Object) (RawObject, error) {
+	res := make(RawObject, len(o))
+	for k, v := range o {
+		j, err := TryFrom(v)
+		if err != nil {
+			return nil, fmt.Errorf("jason: key %q: %w", k, err)
+		}
+		res[k] = j
+	}
+	return res, nil
+}
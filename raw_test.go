@@ -0,0 +1,192 @@
+package jason
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var rawObject = RawObject{
+	"b": RawValue(`[1, 2]`),
+	"a": RawValue(`1`),
+	"c": RawValue(`{"x": true}`),
+}
+
+func TestKeys(t *testing.T) {
+	if got, want := Keys(rawObject), []string{"a", "b", "c"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Keys() = %q, want %q", got, want)
+	}
+	if got := Keys(nil); got == nil || len(got) != 0 {
+		t.Errorf("Keys(nil) = %#v, want []", got)
+	}
+}
+
+func TestValues(t *testing.T) {
+	want := []RawValue{RawValue(`1`), RawValue(`[1, 2]`), RawValue(`{"x": true}`)}
+	if got := Values(rawObject); !reflect.DeepEqual(got, want) {
+		t.Errorf("Values() = %s, want %s", got, want)
+	}
+	if got := Values(nil); len(got) != 0 {
+		t.Errorf("Values(nil) = %s, want []", got)
+	}
+}
+
+func TestMapValues(t *testing.T) {
+	got, err := MapValues(rawObject, Compact)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := RawObject{
+		"a": RawValue(`1`),
+		"b": RawValue(`[1,2]`),
+		"c": RawValue(`{"x":true}`),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("MapValues() = %s, want %s", got, want)
+	}
+	if string(rawObject["b"]) != `[1, 2]` {
+		t.Errorf("MapValues() modified its input: %s", rawObject["b"])
+	}
+
+	errFn := errors.New("fn failed")
+	_, err = MapValues(rawObject, func(v RawValue) (RawValue, error) {
+		if string(v) == `1` {
+			return nil, errFn
+		}
+		return v, nil
+	})
+	if !errors.Is(err, errFn) || !strings.Contains(err.Error(), `key "a"`) {
+		t.Errorf("MapValues() error = %v, want key \"a\"", err)
+	}
+}
+
+func TestPick(t *testing.T) {
+	got := Pick(rawObject, "a", "c", "missing")
+	want := RawObject{"a": rawObject["a"], "c": rawObject["c"]}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Pick() = %s, want %s", got, want)
+	}
+	if got := Pick(rawObject); len(got) != 0 {
+		t.Errorf("Pick() = %s, want {}", got)
+	}
+	if got := Pick(nil, "a"); got == nil || len(got) != 0 {
+		t.Errorf("Pick(nil) = %#v, want {}", got)
+	}
+}
+
+func TestOmit(t *testing.T) {
+	got := Omit(rawObject, "a", "c", "missing")
+	want := RawObject{"b": rawObject["b"]}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Omit() = %s, want %s", got, want)
+	}
+	if len(rawObject) != 3 {
+		t.Errorf("Omit() modified its input: %s", rawObject)
+	}
+	if got := Omit(rawObject); !reflect.DeepEqual(got, rawObject) {
+		t.Errorf("Omit() = %s, want %s", got, rawObject)
+	}
+}
+
+func TestEntries(t *testing.T) {
+	got := RawObject{}
+	for k, v := range Entries(rawObject) {
+		got[k] = v
+	}
+	if !reflect.DeepEqual(got, rawObject) {
+		t.Errorf("Entries() = %s, want %s", got, rawObject)
+	}
+
+	n := 0
+	for range Entries(rawObject) {
+		if n++; n == 2 {
+			break
+		}
+	}
+	if n != 2 {
+		t.Errorf("Entries() yielded %d entries after break, want 2", n)
+	}
+}
+
+func TestSortedEntries(t *testing.T) {
+	var keys []string
+	var values []RawValue
+	for k, v := range SortedEntries(rawObject) {
+		keys = append(keys, k)
+		values = append(values, v)
+	}
+	if !reflect.DeepEqual(keys, Keys(rawObject)) || !reflect.DeepEqual(values, Values(rawObject)) {
+		t.Errorf("SortedEntries() = %q %s, want sorted", keys, values)
+	}
+
+	keys = nil
+	for k := range SortedEntries(rawObject) {
+		if keys = append(keys, k); k == "b" {
+			break
+		}
+	}
+	if want := []string{"a", "b"}; !reflect.DeepEqual(keys, want) {
+		t.Errorf("SortedEntries() = %q after break, want %q", keys, want)
+	}
+}
+
+func TestIndexedEntries(t *testing.T) {
+	arr := RawArray{RawValue(`1`), RawValue(`"a"`), RawValue(`null`)}
+	var got RawArray
+	for i, v := range IndexedEntries(arr) {
+		if i != len(got) {
+			t.Errorf("IndexedEntries() yielded index %d, want %d", i, len(got))
+		}
+		if got = append(got, v); i == 1 {
+			break
+		}
+	}
+	if !reflect.DeepEqual(got, arr[:2]) {
+		t.Errorf("IndexedEntries() = %s, want %s", got, arr[:2])
+	}
+}
+
+func TestMaterialize(t *testing.T) {
+	got, err := Materialize(rawObject)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Object{
+		"a": Number("1"),
+		"b": Array{Number("1"), Number("2")},
+		"c": Object{"x": true},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Materialize() = %v, want %v", got, want)
+	}
+
+	_, err = Materialize(RawObject{"a": RawValue(`1`), "z": RawValue(`[`)})
+	if err == nil || !strings.Contains(err.Error(), `key "z"`) {
+		t.Errorf("Materialize() error = %v, want key \"z\"", err)
+	}
+}
+
+func TestRawify(t *testing.T) {
+	tree, err := Materialize(rawObject)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := Rawify(tree)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := RawObject{
+		"a": RawValue(`1`),
+		"b": RawValue(`[1,2]`),
+		"c": RawValue(`{"x":true}`),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Rawify() = %s, want %s", got, want)
+	}
+
+	_, err = Rawify(Object{"f": func() {}})
+	if err == nil || !strings.Contains(err.Error(), `key "f"`) {
+		t.Errorf("Rawify() error = %v, want key \"f\"", err)
+	}
+}
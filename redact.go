@@ -0,0 +1,38 @@
+package jason
+
+import "encoding/json"
+
+// Redact returns a copy of doc with the values at paths
+// matching any of patterns replaced by "***".
+//
+// Patterns are JSON Pointers where a "*" token
+// matches any single object key or array index,
+// and a "**" token matches any number of them.
+// Patterns that match nothing are ignored.
+//
+// Example of masking every user's password:
+//
+//	jason.Redact(doc, "/users/*/password")
+func Redact(doc RawValue, patterns ...string) (RawValue, error) {
+	return RedactWith(doc, "***", patterns...)
+}
+
+// RedactWith is like Redact, but uses placeholder
+// to replace the values at matching paths.
+func RedactWith(doc RawValue, placeholder string, patterns ...string) (RawValue, error) {
+	m, err := newMatcher(patterns)
+	if err != nil {
+		return nil, err
+	}
+	tree, err := decodeTree(doc)
+	if err != nil {
+		return nil, err
+	}
+	tree, err = m.replaceTree(tree, m.start(), func(any) (any, error) {
+		return placeholder, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(tree)
+}
@@ -0,0 +1,50 @@
+package jason
+
+import "testing"
+
+func TestRedact(t *testing.T) {
+	doc := RawValue(`{"users":[{"name":"Bob","password":"x"},{"name":"Eve","password":"y","keys":{"ssh":"z"}}],"token":"t"}`)
+	tests := []struct {
+		name     string
+		patterns []string
+		want     string
+	}{
+		{"none", nil, string(doc)},
+		{"key", []string{"/token"}, `{"users":[{"name":"Bob","password":"x"},{"name":"Eve","password":"y","keys":{"ssh":"z"}}],"token":"***"}`},
+		{"index", []string{"/users/1"}, `{"users":[{"name":"Bob","password":"x"},"***"],"token":"t"}`},
+		{"star", []string{"/users/*/password"}, `{"users":[{"name":"Bob","password":"***"},{"name":"Eve","password":"***","keys":{"ssh":"z"}}],"token":"t"}`},
+		{"globstar", []string{"/**/ssh"}, `{"users":[{"name":"Bob","password":"x"},{"name":"Eve","password":"y","keys":{"ssh":"***"}}],"token":"t"}`},
+		{"globstar root", []string{"/**/token"}, `{"users":[{"name":"Bob","password":"x"},{"name":"Eve","password":"y","keys":{"ssh":"z"}}],"token":"***"}`},
+		{"several", []string{"/token", "/users/0/name"}, `{"users":[{"name":"***","password":"x"},{"name":"Eve","password":"y","keys":{"ssh":"z"}}],"token":"***"}`},
+		{"missing", []string{"/nothing/*"}, string(doc)},
+		{"whole", []string{""}, `"***"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Redact(doc, tt.patterns...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !Equal(got, RawValue(tt.want)) {
+				t.Errorf("Redact() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := Redact(doc, "token"); err == nil {
+		t.Error("Redact() accepted an invalid pattern")
+	}
+	if _, err := Redact(RawValue(`{`), "/a"); err == nil {
+		t.Error("Redact() accepted invalid JSON")
+	}
+}
+
+func TestRedactWith(t *testing.T) {
+	got, err := RedactWith(RawValue(`{"a":{"b":1},"c":[1,2]}`), "", "/a/b", "/c/*")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"a":{"b":""},"c":["",""]}`; !Equal(got, RawValue(want)) {
+		t.Errorf("RedactWith() = %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,140 @@
+package jason
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var errKeyCollision = errors.New("key collision")
+
+// RenameKeys returns a copy of j with every object key, at any level,
+// that is in mapping renamed to its mapped value.
+//
+// Renaming a key to a key already in the object, or two keys
+// to the same key, is an error that names the path of the collision.
+//
+// Example of migrating a renamed field:
+//
+//	jason.RenameKeys(j, map[string]string{"user_name": "username"})
+func RenameKeys(j RawValue, mapping map[string]string) (RawValue, error) {
+	return RenameKeysFunc(j, func(key string) string {
+		if k, ok := mapping[key]; ok {
+			return k
+		}
+		return key
+	})
+}
+
+// RenameKeysFunc is like RenameKeys,
+// but renames every object key to the result of calling fn on it.
+func RenameKeysFunc(j RawValue, fn func(key string) string) (RawValue, error) {
+	return renameKeys(j, func(key, _ string) string { return fn(key) })
+}
+
+func renameKeys(j RawValue, fn func(key, ptr string) string) (RawValue, error) {
+	tree, err := decodeTree(j)
+	if err != nil {
+		return nil, err
+	}
+	if tree, err = renameTree(tree, nil, fn); err != nil {
+		return nil, withOp("rename", err)
+	}
+	return json.Marshal(tree)
+}
+
+func renameTree(v any, path []string, fn func(key, ptr string) string) (any, error) {
+	switch v := v.(type) {
+	case Object:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		res := make(Object, len(v))
+		for _, k := range keys {
+			p := append(path[:len(path):len(path)], k)
+			e, err := renameTree(v[k], p, fn)
+			if err != nil {
+				return nil, err
+			}
+			n := fn(k, JoinPointer(p...))
+			if _, dup := res[n]; dup {
+				return nil, pathError(append(path[:len(path):len(path)], n), errKeyCollision)
+			}
+			res[n] = e
+		}
+		return res, nil
+	case Array:
+		res := make(Array, len(v))
+		for i, e := range v {
+			e, err := renameTree(e, append(path[:len(path):len(path)], strconv.Itoa(i)), fn)
+			if err != nil {
+				return nil, err
+			}
+			res[i] = e
+		}
+		return res, nil
+	}
+	return v, nil
+}
+
+// MapKeys returns a copy of j with every object key, at any level,
+// renamed to the result of calling fn on it,
+// and on the JSON Pointer to its value.
+//
+// Collisions are an error, as for RenameKeys.
+//
+// Example of converting an API response to camelCase:
+//
+//	jason.MapKeys(j, jason.SnakeToCamel)
+func MapKeys(j RawValue, fn func(key, ptr string) string) (RawValue, error) {
+	return renameKeys(j, fn)
+}
+
+// SnakeToCamel converts key from snake_case to camelCase,
+// for use with MapKeys. The pointer is ignored.
+func SnakeToCamel(key, _ string) string {
+	var buf strings.Builder
+	upper := false
+	for i, r := range key {
+		switch {
+		case r == '_' && i > 0:
+			upper = true
+		case upper:
+			buf.WriteRune(unicode.ToUpper(r))
+			upper = false
+		default:
+			buf.WriteRune(r)
+		}
+	}
+	if upper {
+		buf.WriteByte('_')
+	}
+	return buf.String()
+}
+
+// CamelToSnake converts key from camelCase to snake_case,
+// for use with MapKeys. The pointer is ignored.
+//
+// Runs of uppercase letters are treated as a single word,
+// so "userID" and "HTTPServer" become "user_id" and "http_server".
+func CamelToSnake(key, _ string) string {
+	var buf strings.Builder
+	runes := []rune(key)
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			if i > 0 && runes[i-1] != '_' && (!unicode.IsUpper(runes[i-1]) ||
+				i+1 < len(runes) && unicode.IsLower(runes[i+1])) {
+				buf.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		buf.WriteRune(r)
+	}
+	return buf.String()
+}
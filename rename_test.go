@@ -0,0 +1,126 @@
+package jason
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestRenameKeys(t *testing.T) {
+	doc := RawValue(`{"user_name":"a","items":[{"user_name":"b","id":1}],"meta":{"id":2}}`)
+	tests := []struct {
+		name    string
+		mapping map[string]string
+		want    string
+		path    string
+	}{
+		{"none", nil, string(doc), ""},
+		{"nested", map[string]string{"user_name": "username"},
+			`{"username":"a","items":[{"username":"b","id":1}],"meta":{"id":2}}`, ""},
+		{"swap", map[string]string{"items": "meta", "meta": "items"},
+			`{"user_name":"a","meta":[{"user_name":"b","id":1}],"items":{"id":2}}`, ""},
+		{"existing", map[string]string{"user_name": "id"}, "", "/items/0/id"},
+		{"two keys", map[string]string{"user_name": "x", "items": "x"}, "", "/x"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RenameKeys(doc, tt.mapping)
+			if tt.path != "" {
+				var perr *PathError
+				if !errors.As(err, &perr) || perr.Path != tt.path || !errors.Is(err, errKeyCollision) {
+					t.Fatalf("RenameKeys() error = %v, want a collision at %s", err, tt.path)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !Equal(got, RawValue(tt.want)) {
+				t.Errorf("RenameKeys() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+	if _, err := RenameKeys(RawValue(`{"a"`), nil); err == nil {
+		t.Error("RenameKeys() accepted invalid JSON")
+	}
+}
+
+func TestRenameKeysFunc(t *testing.T) {
+	got, err := RenameKeysFunc(RawValue(`[{"a":{"b":1}},2]`), strings.ToUpper)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `[{"A":{"B":1}},2]`; !Equal(got, RawValue(want)) {
+		t.Errorf("RenameKeysFunc() = %s, want %s", got, want)
+	}
+	if _, err := RenameKeysFunc(RawValue(`{"a":1,"A":2}`), strings.ToUpper); !errors.Is(err, errKeyCollision) {
+		t.Errorf("RenameKeysFunc() error = %v, want a collision", err)
+	}
+}
+
+func TestMapKeys(t *testing.T) {
+	var ptrs []string
+	got, err := MapKeys(RawValue(`{"a":[{"b":1}],"c":{}}`), func(key, ptr string) string {
+		ptrs = append(ptrs, ptr)
+		return key + "_"
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"a_":[{"b_":1}],"c_":{}}`; !Equal(got, RawValue(want)) {
+		t.Errorf("MapKeys() = %s, want %s", got, want)
+	}
+	if want := "/a/0/b /a /c"; strings.Join(ptrs, " ") != want {
+		t.Errorf("MapKeys() pointers = %q, want %q", ptrs, want)
+	}
+
+	got, err = MapKeys(RawValue(`{"user_id":1,"addr":{"zip_code":"x"}}`), SnakeToCamel)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"userId":1,"addr":{"zipCode":"x"}}`; !Equal(got, RawValue(want)) {
+		t.Errorf("MapKeys() = %s, want %s", got, want)
+	}
+	if _, err := MapKeys(RawValue(`{"a_b":1,"aB":2}`), SnakeToCamel); !errors.Is(err, errKeyCollision) {
+		t.Errorf("MapKeys() error = %v, want a collision", err)
+	}
+}
+
+func TestSnakeToCamel(t *testing.T) {
+	tests := []struct{ in, want string }{
+		{"", ""},
+		{"id", "id"},
+		{"user_id", "userId"},
+		{"a_b_c", "aBC"},
+		{"_private", "_private"},
+		{"trailing_", "trailing_"},
+		{"double__under", "doubleUnder"},
+		{"año_nuevo", "añoNuevo"},
+		{"über_élan", "überÉlan"},
+	}
+	for _, tt := range tests {
+		if got := SnakeToCamel(tt.in, ""); got != tt.want {
+			t.Errorf("SnakeToCamel(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestCamelToSnake(t *testing.T) {
+	tests := []struct{ in, want string }{
+		{"", ""},
+		{"id", "id"},
+		{"userId", "user_id"},
+		{"userID", "user_id"},
+		{"HTTPServer", "http_server"},
+		{"ID", "id"},
+		{"Name", "name"},
+		{"already_snake", "already_snake"},
+		{"snake_Case", "snake_case"},
+		{"überÉlan", "über_élan"},
+	}
+	for _, tt := range tests {
+		if got := CamelToSnake(tt.in, ""); got != tt.want {
+			t.Errorf("CamelToSnake(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
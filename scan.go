@@ -0,0 +1,75 @@
+package jason
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+)
+
+// tokenScanner reads the tokens of a single JSON value,
+// tracking which strings are object keys.
+type tokenScanner struct {
+	dec   *json.Decoder
+	stack []byte // for each open container: 'k' or 'v' for objects, 'a' for arrays
+	done  bool
+}
+
+func newTokenScanner(j RawValue) *tokenScanner {
+	dec := json.NewDecoder(bytes.NewReader(j))
+	dec.UseNumber()
+	return &tokenScanner{dec: dec}
+}
+
+// next returns the next token, whether it is an object key,
+// and its depth: the number of containers enclosing it.
+// It returns io.EOF after the value is complete.
+func (s *tokenScanner) next() (tok json.Token, key bool, depth int, err error) {
+	if s.done {
+		if _, err := s.dec.Token(); err != io.EOF {
+			if err == nil {
+				err = errTrailingData
+			}
+			return nil, false, 0, err
+		}
+		return nil, false, 0, io.EOF
+	}
+
+	tok, err = s.dec.Token()
+	if err != nil {
+		return nil, false, 0, unexpectedEOF(err)
+	}
+
+	depth = len(s.stack)
+	switch tok {
+	case json.Delim('{'):
+		s.value()
+		s.stack = append(s.stack, 'k')
+	case json.Delim('['):
+		s.value()
+		s.stack = append(s.stack, 'a')
+	case json.Delim('}'), json.Delim(']'):
+		depth--
+		s.stack = s.stack[:depth]
+	default:
+		if depth > 0 && s.stack[depth-1] == 'k' {
+			s.stack[depth-1] = 'v'
+			key = true
+		} else {
+			s.value()
+		}
+	}
+	s.done = len(s.stack) == 0
+	return tok, key, depth, nil
+}
+
+// value records that a value started in the current container.
+func (s *tokenScanner) value() {
+	if n := len(s.stack); n > 0 && s.stack[n-1] == 'v' {
+		s.stack[n-1] = 'k'
+	}
+}
+
+// offset returns the offset in the input after the last token read.
+func (s *tokenScanner) offset() int64 {
+	return s.dec.InputOffset()
+}
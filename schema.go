@@ -0,0 +1,364 @@
+package jason
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"unicode/utf8"
+)
+
+// SchemaOption configures InferSchema.
+type SchemaOption uint
+
+const (
+	// InferRequired marks all the object properties found in the sample as required.
+	InferRequired SchemaOption = 1 << iota
+)
+
+// InferSchema returns a draft-07 JSON Schema describing
+// the structure of the sample document j.
+//
+// Objects are described by their properties, arrays by their items,
+// and scalars by their type. The schemas of the elements of an array
+// are merged: types are unioned, and object properties combined.
+// Since a single sample cannot tell which properties are optional,
+// "required" is only emitted with the InferRequired option,
+// listing the properties present in every sampled object.
+//
+// Example of bootstrapping a schema from an example payload:
+//
+//	schema, err := jason.InferSchema(payload)
+func InferSchema(j RawValue, opts ...SchemaOption) (RawValue, error) {
+	tree, err := decodeTree(j)
+	if err != nil {
+		return nil, err
+	}
+	var o SchemaOption
+	for _, opt := range opts {
+		o |= opt
+	}
+
+	s := inferSchema(tree).object(o&InferRequired != 0)
+	s["$schema"] = "http://json-schema.org/draft-07/schema#"
+	return json.Marshal(s)
+}
+
+type inferred struct {
+	types    map[string]bool
+	props    map[string]*inferred
+	required map[string]bool // properties present in all objects
+	items    *inferred
+}
+
+func inferSchema(v any) *inferred {
+	s := &inferred{types: map[string]bool{}}
+	switch v := v.(type) {
+	case nil:
+		s.types["null"] = true
+	case bool:
+		s.types["boolean"] = true
+	case string:
+		s.types["string"] = true
+	case Number:
+		if IsInteger(v) {
+			s.types["integer"] = true
+		} else {
+			s.types["number"] = true
+		}
+	case Object:
+		s.types["object"] = true
+		s.props = map[string]*inferred{}
+		s.required = map[string]bool{}
+		for k, e := range v {
+			s.props[k] = inferSchema(e)
+			s.required[k] = true
+		}
+	case Array:
+		s.types["array"] = true
+		for _, e := range v {
+			s.items = s.items.merge(inferSchema(e))
+		}
+	}
+	return s
+}
+
+// merge combines the schemas s and t, either of which may be nil.
+func (s *inferred) merge(t *inferred) *inferred {
+	if s == nil {
+		return t
+	}
+	if t == nil {
+		return s
+	}
+	for typ := range t.types {
+		s.types[typ] = true
+	}
+	if s.props == nil {
+		s.props, s.required = t.props, t.required
+	} else if t.props != nil {
+		for k := range s.required {
+			if !t.required[k] {
+				delete(s.required, k)
+			}
+		}
+		for k, p := range t.props {
+			s.props[k] = s.props[k].merge(p)
+		}
+	}
+	s.items = s.items.merge(t.items)
+	return s
+}
+
+func (s *inferred) object(required bool) Object {
+	if s.types["number"] {
+		delete(s.types, "integer")
+	}
+	types := make([]string, 0, len(s.types))
+	for typ := range s.types {
+		types = append(types, typ)
+	}
+	sort.Strings(types)
+
+	o := Object{}
+	if len(types) == 1 {
+		o["type"] = types[0]
+	} else {
+		o["type"] = types
+	}
+	if s.props != nil {
+		props := Object{}
+		for k, p := range s.props {
+			props[k] = p.object(required)
+		}
+		o["properties"] = props
+		if required && len(s.required) > 0 {
+			keys := make([]string, 0, len(s.required))
+			for k := range s.required {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			o["required"] = keys
+		}
+	}
+	if s.items != nil {
+		o["items"] = s.items.object(required)
+	}
+	return o
+}
+
+// SchemaError is an error returned by ValidateSchema,
+// for a value that does not satisfy a schema keyword.
+type SchemaError struct {
+	Path    string // JSON Pointer to the invalid value
+	Keyword string // the unsatisfied keyword, e.g. "type"
+	Message string
+}
+
+func (e *SchemaError) Error() string {
+	if e.Path == "" {
+		return "jason: " + e.Message
+	}
+	return "jason: " + e.Path + ": " + e.Message
+}
+
+// ValidateSchema validates doc against the JSON Schema schema,
+// and returns all the validation errors found,
+// as [*SchemaError] values, or nil if doc is valid.
+//
+// Only a subset of JSON Schema is supported:
+// boolean schemas, and the type, required, properties, items, enum,
+// minimum, maximum, minLength, maxLength and pattern keywords.
+// Other keywords are ignored.
+// Malformed input is returned as the only error.
+//
+// Example of validating a request body:
+//
+//	for _, err := range jason.ValidateSchema(body, schema) { ... }
+func ValidateSchema(doc, schema RawValue) []error {
+	d, err := decodeTree(doc)
+	if err != nil {
+		return []error{err}
+	}
+	s, err := decodeTree(schema)
+	if err != nil {
+		return []error{err}
+	}
+	var v validator
+	v.validate(nil, d, s)
+	return v.errs
+}
+
+type validator struct {
+	errs []error
+}
+
+func (v *validator) errorf(path []string, keyword, format string, args ...any) {
+	v.errs = append(v.errs, &SchemaError{
+		Path:    JoinPointer(path...),
+		Keyword: keyword,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+func (v *validator) validate(path []string, doc, schema any) {
+	var s Object
+	switch schema := schema.(type) {
+	case bool:
+		if !schema {
+			v.errorf(path, "false", "schema is false")
+		}
+		return
+	case Object:
+		s = schema
+	default:
+		v.errorf(path, "", "invalid schema: %s", From(schema))
+		return
+	}
+
+	if t, ok := s["type"]; ok {
+		v.validateType(path, doc, t)
+	}
+	if e, ok := s["enum"].(Array); ok {
+		found := false
+		for _, c := range e {
+			if equal(doc, c) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			v.errorf(path, "enum", "value must be one of %s", From(e))
+		}
+	}
+
+	switch doc := doc.(type) {
+	case Object:
+		if req, ok := s["required"].(Array); ok {
+			for _, k := range req {
+				if k, ok := k.(string); ok {
+					if _, ok := doc[k]; !ok {
+						v.errorf(path, "required", "missing required property %q", k)
+					}
+				}
+			}
+		}
+		if props, ok := s["properties"].(Object); ok {
+			keys := make([]string, 0, len(props))
+			for k := range props {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			for _, k := range keys {
+				if e, ok := doc[k]; ok {
+					v.validate(append(path, k), e, props[k])
+				}
+			}
+		}
+
+	case Array:
+		switch items := s["items"].(type) {
+		case nil:
+		case Array:
+			for i := 0; i < len(doc) && i < len(items); i++ {
+				v.validate(append(path, strconv.Itoa(i)), doc[i], items[i])
+			}
+		default:
+			for i, e := range doc {
+				v.validate(append(path, strconv.Itoa(i)), e, items)
+			}
+		}
+
+	case Number:
+		x, err := ToBigRat(doc)
+		if err != nil {
+			break
+		}
+		if m, ok := s["minimum"].(Number); ok {
+			if y, err := ToBigRat(m); err == nil && x.Cmp(y) < 0 {
+				v.errorf(path, "minimum", "value must be >= %s", m)
+			}
+		}
+		if m, ok := s["maximum"].(Number); ok {
+			if y, err := ToBigRat(m); err == nil && x.Cmp(y) > 0 {
+				v.errorf(path, "maximum", "value must be <= %s", m)
+			}
+		}
+
+	case string:
+		n := utf8.RuneCountInString(doc)
+		if m, ok := s["minLength"].(Number); ok {
+			if l, err := m.Int64(); err == nil && int64(n) < l {
+				v.errorf(path, "minLength", "length must be >= %s", m)
+			}
+		}
+		if m, ok := s["maxLength"].(Number); ok {
+			if l, err := m.Int64(); err == nil && int64(n) > l {
+				v.errorf(path, "maxLength", "length must be <= %s", m)
+			}
+		}
+		if p, ok := s["pattern"].(string); ok {
+			re, err := regexp.Compile(p)
+			if err != nil {
+				v.errorf(path, "pattern", "invalid pattern %q: %v", p, err)
+			} else if !re.MatchString(doc) {
+				v.errorf(path, "pattern", "value must match %q", p)
+			}
+		}
+	}
+}
+
+func (v *validator) validateType(path []string, doc, t any) {
+	var types []string
+	switch t := t.(type) {
+	case string:
+		types = []string{t}
+	case Array:
+		for _, e := range t {
+			if e, ok := e.(string); ok {
+				types = append(types, e)
+			}
+		}
+	}
+	for _, typ := range types {
+		if schemaType(doc, typ) {
+			return
+		}
+	}
+	if len(types) == 1 {
+		v.errorf(path, "type", "expected %s, got %s", types[0], schemaTypeOf(doc))
+	} else {
+		v.errorf(path, "type", "expected one of %s, got %s", From(types), schemaTypeOf(doc))
+	}
+}
+
+func schemaType(v any, typ string) bool {
+	switch typ {
+	case "integer":
+		n, ok := v.(Number)
+		return ok && IsInteger(n)
+	case "number":
+		_, ok := v.(Number)
+		return ok
+	}
+	return schemaTypeOf(v) == typ
+}
+
+func schemaTypeOf(v any) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case Number:
+		return "number"
+	case Object:
+		return "object"
+	case Array:
+		return "array"
+	}
+	return "unknown"
+}
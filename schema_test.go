@@ -0,0 +1,140 @@
+package jason
+
+import "testing"
+
+func TestInferSchema(t *testing.T) {
+	const draft = `"$schema":"http://json-schema.org/draft-07/schema#",`
+	tests := []struct {
+		name string
+		in   string
+		opts []SchemaOption
+		want string
+	}{
+		{"null", `null`, nil, `{` + draft + `"type":"null"}`},
+		{"integer", `1`, nil, `{` + draft + `"type":"integer"}`},
+		{"number", `1.5`, nil, `{` + draft + `"type":"number"}`},
+		{"object", `{"a":"s","b":true}`, nil, `{` + draft + `"type":"object","properties":{
+			"a":{"type":"string"},
+			"b":{"type":"boolean"}
+		}}`},
+		{"empty array", `[]`, nil, `{` + draft + `"type":"array"}`},
+		{"array", `[1, 2.5, null]`, nil, `{` + draft + `"type":"array","items":{"type":["null","number"]}}`},
+		{"objects", `[{"a":1,"b":"x"},{"a":2,"c":{"d":[]}}]`, nil, `{` + draft + `"type":"array","items":{
+			"type":"object",
+			"properties":{
+				"a":{"type":"integer"},
+				"b":{"type":"string"},
+				"c":{"type":"object","properties":{"d":{"type":"array"}}}
+			}
+		}}`},
+		{"required", `[{"a":1,"b":"x"},{"a":2,"c":{"d":1}}]`, []SchemaOption{InferRequired}, `{` + draft + `"type":"array","items":{
+			"type":"object",
+			"properties":{
+				"a":{"type":"integer"},
+				"b":{"type":"string"},
+				"c":{"type":"object","properties":{"d":{"type":"integer"}},"required":["d"]}
+			},
+			"required":["a"]
+		}}`},
+		{"mixed", `[{"a":1}, "s"]`, []SchemaOption{InferRequired}, `{` + draft + `"type":"array","items":{
+			"type":["object","string"],
+			"properties":{"a":{"type":"integer"}},
+			"required":["a"]
+		}}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := InferSchema(RawValue(tt.in), tt.opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !Equal(got, RawValue(tt.want)) {
+				t.Errorf("InferSchema() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+	if _, err := InferSchema(RawValue(`{`)); err == nil {
+		t.Error("InferSchema() accepted invalid JSON")
+	}
+}
+
+func TestValidateSchema(t *testing.T) {
+	schema := RawValue(`{
+		"type": "object",
+		"required": ["name", "age"],
+		"properties": {
+			"name": {"type": "string", "minLength": 2, "maxLength": 4, "pattern": "^[A-Z]"},
+			"age": {"type": "integer", "minimum": 0, "maximum": 150},
+			"role": {"enum": ["admin", "user", null]},
+			"tags": {"type": "array", "items": {"type": "string"}},
+			"pair": {"items": [{"type": "number"}, {"type": ["string", "null"]}]},
+			"none": false,
+			"any": true
+		}
+	}`)
+	tests := []struct {
+		name string
+		doc  string
+		want []SchemaError
+	}{
+		{"valid", `{"name": "Bob", "age": 30, "role": null, "tags": ["a"], "pair": [1.5, null, 3], "any": {}}`, nil},
+		{"not object", `[]`, []SchemaError{{"", "type", "expected object, got array"}}},
+		{"required", `{"age": 1}`, []SchemaError{{"", "required", `missing required property "name"`}}},
+		{"string", `{"name": "bobby", "age": 1}`, []SchemaError{
+			{"/name", "maxLength", "length must be <= 4"},
+			{"/name", "pattern", `value must match "^[A-Z]"`},
+		}},
+		{"short", `{"name": "B", "age": 1}`, []SchemaError{{"/name", "minLength", "length must be >= 2"}}},
+		{"runes", `{"name": "Añño", "age": 1}`, nil},
+		{"integer", `{"name": "Bob", "age": 1.5}`, []SchemaError{{"/age", "type", "expected integer, got number"}}},
+		{"range", `{"name": "Bob", "age": -1}`, []SchemaError{{"/age", "minimum", "value must be >= 0"}}},
+		{"enum", `{"name": "Bob", "age": 1, "role": "root"}`, []SchemaError{{"/role", "enum", `value must be one of ["admin","user",null]`}}},
+		{"items", `{"name": "Bob", "age": 1, "tags": ["a", 1, "b", true]}`, []SchemaError{
+			{"/tags/1", "type", "expected string, got number"},
+			{"/tags/3", "type", "expected string, got boolean"},
+		}},
+		{"tuple", `{"name": "Bob", "age": 1, "pair": ["x", 1]}`, []SchemaError{
+			{"/pair/0", "type", "expected number, got string"},
+			{"/pair/1", "type", `expected one of ["string","null"], got number`},
+		}},
+		{"false", `{"name": "Bob", "age": 1, "none": 1}`, []SchemaError{{"/none", "false", "schema is false"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := ValidateSchema(RawValue(tt.doc), schema)
+			if len(errs) != len(tt.want) {
+				t.Fatalf("ValidateSchema() = %v, want %v", errs, tt.want)
+			}
+			for i, err := range errs {
+				if serr, ok := err.(*SchemaError); !ok || *serr != tt.want[i] {
+					t.Errorf("ValidateSchema()[%d] = %#v, want %#v", i, err, tt.want[i])
+				}
+			}
+		})
+	}
+
+	if errs := ValidateSchema(RawValue(`{`), schema); len(errs) != 1 {
+		t.Errorf("ValidateSchema() = %v, want a single error", errs)
+	}
+	if errs := ValidateSchema(RawValue(`1`), RawValue(`{"pattern": 1`)); len(errs) != 1 {
+		t.Errorf("ValidateSchema() = %v, want a single error", errs)
+	}
+	if errs := ValidateSchema(RawValue(`"a"`), RawValue(`{"pattern": "["}`)); len(errs) != 1 || errs[0].(*SchemaError).Keyword != "pattern" {
+		t.Errorf("ValidateSchema() = %v, want an invalid pattern error", errs)
+	}
+}
+
+func TestValidateSchema_inferred(t *testing.T) {
+	for _, in := range []string{
+		`[{"a":1,"b":"x"},{"a":2.5,"c":{"d":[]}}]`,
+		`{"a":[1,"s",null,{"b":true}]}`,
+	} {
+		schema, err := InferSchema(RawValue(in), InferRequired)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if errs := ValidateSchema(RawValue(in), schema); errs != nil {
+			t.Errorf("ValidateSchema(%s) = %v, want no errors", in, errs)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package jason
+
+import (
+	"encoding/json"
+	"sort"
+)
+
+// SortBy returns a copy of arr sorted by the value
+// at the JSON Pointer ptr within each element, as ordered by less.
+//
+// The sort is stable.
+// Elements where ptr does not resolve to a value sort last,
+// in their original order.
+//
+// Example of ordering records by an embedded timestamp:
+//
+//	jason.SortBy(arr, "/meta/created", jason.ByNumber)
+func SortBy(arr RawArray, ptr string, less func(a, b RawValue) bool) (RawArray, error) {
+	if _, err := SplitPointer(ptr); err != nil {
+		return nil, err
+	}
+
+	type elem struct {
+		val RawValue
+		key RawValue
+		ok  bool
+	}
+	elems := make([]elem, len(arr))
+	for i, v := range arr {
+		key, err := Pointer(v, ptr)
+		if err != nil && !isMissing(err) {
+			return nil, err
+		}
+		elems[i] = elem{v, key, err == nil}
+	}
+
+	sort.SliceStable(elems, func(i, j int) bool {
+		a, b := elems[i], elems[j]
+		if !a.ok || !b.ok {
+			return a.ok
+		}
+		return less(a.key, b.key)
+	})
+
+	res := make(RawArray, len(elems))
+	for i, e := range elems {
+		res[i] = e.val
+	}
+	return res, nil
+}
+
+// ByNumber orders JSON numbers by value.
+// Other values sort after numbers.
+func ByNumber(a, b RawValue) bool {
+	x, xok := sortNumber(a)
+	y, yok := sortNumber(b)
+	if !xok || !yok {
+		return xok
+	}
+	rx, _ := ToBigRat(x)
+	ry, _ := ToBigRat(y)
+	return rx.Cmp(ry) < 0
+}
+
+// ByString orders JSON strings lexicographically, byte-wise.
+// Other values sort after strings.
+func ByString(a, b RawValue) bool {
+	var x, y string
+	xok := kindOf(a) == StringKind && json.Unmarshal(a, &x) == nil
+	yok := kindOf(b) == StringKind && json.Unmarshal(b, &y) == nil
+	if !xok || !yok {
+		return xok
+	}
+	return x < y
+}
+
+// ByBool orders JSON booleans, false before true.
+// Other values sort after booleans.
+func ByBool(a, b RawValue) bool {
+	var x, y bool
+	xok := kindOf(a) == BoolKind && json.Unmarshal(a, &x) == nil
+	yok := kindOf(b) == BoolKind && json.Unmarshal(b, &y) == nil
+	if !xok || !yok {
+		return xok
+	}
+	return !x && y
+}
+
+func sortNumber(j RawValue) (Number, bool) {
+	var n Number
+	if kindOf(j) != NumberKind || json.Unmarshal(j, &n) != nil {
+		return "", false
+	}
+	return n, true
+}
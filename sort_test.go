@@ -0,0 +1,114 @@
+package jason
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSortBy(t *testing.T) {
+	arr := RawArray{
+		RawValue(`{"id":"a","n":10,"s":"x","b":true}`),
+		RawValue(`{"id":"b","n":2.5,"s":"Y","b":false}`),
+		RawValue(`{"id":"c"}`),
+		RawValue(`{"id":"d","n":"3","s":3,"b":null}`),
+		RawValue(`{"id":"e","n":-1e1,"s":"x","b":false}`),
+		RawValue(`7`),
+	}
+	tests := []struct {
+		name string
+		ptr  string
+		less func(a, b RawValue) bool
+		want []int
+	}{
+		{"number", "/n", ByNumber, []int{4, 1, 0, 3, 2, 5}},
+		{"string", "/s", ByString, []int{1, 0, 4, 3, 2, 5}},
+		{"bool", "/b", ByBool, []int{1, 4, 0, 3, 2, 5}},
+		{"missing", "/z", ByNumber, []int{0, 1, 2, 3, 4, 5}},
+		{"root", "", ByNumber, []int{5, 0, 1, 2, 3, 4}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SortBy(arr, tt.ptr, tt.less)
+			if err != nil {
+				t.Fatal(err)
+			}
+			want := make(RawArray, len(tt.want))
+			for i, j := range tt.want {
+				want[i] = arr[j]
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("SortBy() = %s, want %s", got, want)
+			}
+		})
+	}
+
+	if string(arr[0]) != `{"id":"a","n":10,"s":"x","b":true}` || string(arr[5]) != `7` {
+		t.Errorf("SortBy() modified its input: %s", arr)
+	}
+	if _, err := SortBy(arr, "n", ByNumber); err == nil {
+		t.Error("SortBy() accepted an invalid pointer")
+	}
+	if _, err := SortBy(RawArray{RawValue(`{"n":`)}, "/n", ByNumber); err == nil {
+		t.Error("SortBy() accepted invalid JSON")
+	}
+}
+
+func TestByNumber(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{`1`, `2`, true},
+		{`2`, `1`, false},
+		{`1.0`, `1`, false},
+		{`100000000000000000001`, `100000000000000000002`, true},
+		{`1e2`, `99`, false},
+		{`1`, `"0"`, true},
+		{`"0"`, `1`, false},
+		{`"0"`, `"1"`, false},
+	}
+	for _, tt := range tests {
+		if got := ByNumber(RawValue(tt.a), RawValue(tt.b)); got != tt.want {
+			t.Errorf("ByNumber(%s, %s) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestByString(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{`"a"`, `"b"`, true},
+		{`"b"`, `"a"`, false},
+		{`"B"`, `"a"`, true},
+		{`"a"`, `"a"`, false},
+		{`"\u0041"`, `"B"`, true},
+		{`"é"`, `"z"`, false},
+		{`"z"`, `1`, true},
+		{`1`, `"a"`, false},
+	}
+	for _, tt := range tests {
+		if got := ByString(RawValue(tt.a), RawValue(tt.b)); got != tt.want {
+			t.Errorf("ByString(%s, %s) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestByBool(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{`false`, `true`, true},
+		{`true`, `false`, false},
+		{`true`, `true`, false},
+		{`true`, `null`, true},
+		{`0`, `false`, false},
+	}
+	for _, tt := range tests {
+		if got := ByBool(RawValue(tt.a), RawValue(tt.b)); got != tt.want {
+			t.Errorf("ByBool(%s, %s) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package jason
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// Stats describes the shape of a JSON document.
+type Stats struct {
+	Depth  int // maximum nesting depth, as returned by Depth
+	Nodes  int // number of values, including objects and arrays
+	Leaves int // number of scalar values
+	Bytes  int // length of the document, in bytes
+}
+
+// StatsOf computes the Stats of j in a single pass.
+//
+// Example of tracking payload complexity:
+//
+//	st, err := jason.StatsOf(j)
+//	metrics.Observe(st.Depth, st.Nodes, st.Bytes)
+func StatsOf(j RawValue) (Stats, error) {
+	st := Stats{Bytes: len(j)}
+	err := scanStats(j, &st, -1)
+	if err != nil {
+		return Stats{}, err
+	}
+	return st, nil
+}
+
+// CountNodes returns the number of values in j:
+// objects, arrays and scalars, including j itself.
+// Object keys are not counted.
+func CountNodes(j RawValue) (int, error) {
+	st, err := StatsOf(j)
+	return st.Nodes, err
+}
+
+// CountLeaves returns the number of scalar values in j.
+func CountLeaves(j RawValue) (int, error) {
+	st, err := StatsOf(j)
+	return st.Leaves, err
+}
+
+// Depth returns how deeply nested j is:
+// scalars have depth 0, [1] has depth 1, {"a":{"b":1}} has depth 2.
+//
+// The document is tokenized iteratively,
+// so pathological nesting does not exhaust the stack.
+func Depth(j RawValue) (int, error) {
+	var st Stats
+	if err := scanStats(j, &st, -1); err != nil {
+		return 0, err
+	}
+	return st.Depth, nil
+}
+
+// WithinDepth reports whether j is valid JSON nested at most max levels deep.
+// It stops as soon as the limit is exceeded.
+//
+// Example of rejecting deeply nested input:
+//
+//	if !jason.WithinDepth(j, 32) { ... }
+func WithinDepth(j RawValue, max int) bool {
+	var st Stats
+	err := scanStats(j, &st, max)
+	return err == nil && st.Depth <= max
+}
+
+// scanStats tokenizes j, accumulating into st,
+// and stopping early once depth exceeds max, if max >= 0.
+func scanStats(j RawValue, st *Stats, max int) error {
+	s := newTokenScanner(j)
+	for {
+		tok, key, depth, err := s.next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		switch tok {
+		case json.Delim('}'), json.Delim(']'):
+			continue
+		case json.Delim('{'), json.Delim('['):
+			st.Nodes++
+			if depth >= st.Depth {
+				st.Depth = depth + 1
+				if max >= 0 && st.Depth > max {
+					return nil
+				}
+			}
+		default:
+			if !key {
+				st.Nodes++
+				st.Leaves++
+			}
+		}
+	}
+}
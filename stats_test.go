@@ -0,0 +1,99 @@
+package jason
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDepth(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    int
+		wantErr bool
+	}{
+		{in: `1`, want: 0},
+		{in: `"s"`, want: 0},
+		{in: `[]`, want: 1},
+		{in: `[1]`, want: 1},
+		{in: `{"a":{"b":1}}`, want: 2},
+		{in: `[[],[[{}]],1]`, want: 4},
+		{in: `{"a":[1],"b":{"c":{"d":[]}}}`, want: 4},
+		{in: strings.Repeat("[", 10000) + strings.Repeat("]", 10000), want: 10000},
+		{in: ``, wantErr: true},
+		{in: `[1,`, wantErr: true},
+		{in: `[1] 2`, wantErr: true},
+	}
+	for _, tt := range tests {
+		name := tt.in
+		if len(name) > 20 {
+			name = name[:20]
+		}
+		t.Run(name, func(t *testing.T) {
+			got, err := Depth(RawValue(tt.in))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Depth() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Depth() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithinDepth(t *testing.T) {
+	tests := []struct {
+		in   string
+		max  int
+		want bool
+	}{
+		{`1`, 0, true},
+		{`[1]`, 0, false},
+		{`[1]`, 1, true},
+		{`{"a":{"b":1}}`, 1, false},
+		{`{"a":{"b":1}}`, 2, true},
+		{`[[[` + strings.Repeat("x", 10), 2, false},
+		{`[[1,]]`, 2, false},
+		{`[[1] 2`, 5, false},
+	}
+	for _, tt := range tests {
+		if got := WithinDepth(RawValue(tt.in), tt.max); got != tt.want {
+			t.Errorf("WithinDepth(%s, %d) = %v, want %v", tt.in, tt.max, got, tt.want)
+		}
+	}
+}
+
+func TestStatsOf(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Stats
+	}{
+		{`1`, Stats{Depth: 0, Nodes: 1, Leaves: 1, Bytes: 1}},
+		{`[]`, Stats{Depth: 1, Nodes: 1, Leaves: 0, Bytes: 2}},
+		{`{"a": 1, "b": [true, null]}`, Stats{Depth: 2, Nodes: 5, Leaves: 3, Bytes: 27}},
+		{`[{}, [], {"k": "v"}]`, Stats{Depth: 2, Nodes: 5, Leaves: 1, Bytes: 20}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := StatsOf(RawValue(tt.in))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("StatsOf() = %+v, want %+v", got, tt.want)
+			}
+			if n, err := CountNodes(RawValue(tt.in)); err != nil || n != tt.want.Nodes {
+				t.Errorf("CountNodes() = %d, %v, want %d", n, err, tt.want.Nodes)
+			}
+			if n, err := CountLeaves(RawValue(tt.in)); err != nil || n != tt.want.Leaves {
+				t.Errorf("CountLeaves() = %d, %v, want %d", n, err, tt.want.Leaves)
+			}
+		})
+	}
+
+	if st, err := StatsOf(RawValue(`{"a":`)); err == nil || st != (Stats{}) {
+		t.Errorf("StatsOf() = %+v, %v, want an error", st, err)
+	}
+	if _, err := CountNodes(RawValue(`[1]]`)); err == nil {
+		t.Error("CountNodes() accepted invalid JSON")
+	}
+}
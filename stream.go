@@ -0,0 +1,167 @@
+package jason
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// ArrayReader reads the elements of a JSON array from an input stream,
+// one at a time, without buffering the entire array.
+type ArrayReader struct {
+	dec     *json.Decoder
+	err     error
+	started bool
+}
+
+// NewArrayReader returns a new ArrayReader that reads from r.
+//
+// Example of processing the elements of a large array file:
+//
+//	ar := jason.NewArrayReader(f)
+//	for {
+//		j, err := ar.Next()
+//		if err == io.EOF { break }
+//		...
+//	}
+func NewArrayReader(r io.Reader) *ArrayReader {
+	return &ArrayReader{dec: json.NewDecoder(r)}
+}
+
+// Next returns the next element of the array.
+// It returns [io.EOF] after the last element.
+func (r *ArrayReader) Next() (RawValue, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	v, err := r.next()
+	if err != nil {
+		r.err = err
+	}
+	return v, err
+}
+
+func (r *ArrayReader) next() (RawValue, error) {
+	if !r.started {
+		t, err := r.dec.Token()
+		if err != nil {
+			return nil, unexpectedEOF(err)
+		}
+		if t != json.Delim('[') {
+			return nil, fmt.Errorf("jason: %w", ErrNotArray)
+		}
+		r.started = true
+	}
+
+	if r.dec.More() {
+		var v RawValue
+		if err := r.dec.Decode(&v); err != nil {
+			return nil, unexpectedEOF(err)
+		}
+		return v, nil
+	}
+
+	if _, err := r.dec.Token(); err != nil {
+		return nil, unexpectedEOF(err)
+	}
+	return nil, io.EOF
+}
+
+// ReadValue reads exactly one JSON value from r.
+//
+// Unlike a [json.Decoder], it does not buffer data beyond the value,
+// which is left in r for the caller.
+// The exception is the byte following a top-level number,
+// which must be read to find the end of the number;
+// if r implements [io.ByteScanner], that byte is unread.
+// Since r is read a byte at a time, it should be buffered,
+// e.g. by a [bufio.Reader].
+// It returns [io.EOF] if r has no value before its end.
+//
+// Example of reading values framed on a stream:
+//
+//	br := bufio.NewReader(conn)
+//	j, err := jason.ReadValue(br)
+func ReadValue(r io.Reader) (RawValue, error) {
+	br := byteReader{r: r}
+	br.bs, _ = r.(io.ByteScanner)
+
+	dec := json.NewDecoder(&br)
+	var v RawValue
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	if br.n > dec.InputOffset() && br.bs != nil {
+		if err := br.bs.UnreadByte(); err != nil {
+			return nil, err
+		}
+	}
+	return v, nil
+}
+
+// byteReader reads from r a byte at a time.
+type byteReader struct {
+	r  io.Reader
+	bs io.ByteScanner
+	n  int64
+}
+
+func (r *byteReader) Read(p []byte) (n int, err error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	if r.bs != nil {
+		var b byte
+		if b, err = r.bs.ReadByte(); err == nil {
+			p[0], n = b, 1
+		}
+	} else {
+		n, err = r.r.Read(p[:1])
+	}
+	r.n += int64(n)
+	return n, err
+}
+
+// FilterStream copies the JSON array read from r to w,
+// keeping only the elements for which keep returns true.
+//
+// Elements are read and written one at a time, as by an ArrayReader,
+// so the array is never held in memory.
+// The output is a valid JSON array, which is "[]" if nothing is kept.
+// Errors returned by keep abort the copy, and are returned as is.
+//
+// Example of trimming a large exported dataset:
+//
+//	err := jason.FilterStream(in, out, func(j jason.RawValue) (bool, error) {
+//		active, err := jason.Pointer(j, "/active")
+//		return string(active) == "true", err
+//	})
+func FilterStream(r io.Reader, w io.Writer, keep func(RawValue) (bool, error)) error {
+	ar := NewArrayReader(r)
+	sep := []byte{'['}
+	for {
+		j, err := ar.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		ok, err := keep(j)
+		if err != nil {
+			return err
+		}
+		if ok {
+			if _, err := w.Write(append(sep, j...)); err != nil {
+				return err
+			}
+			sep = []byte{','}
+		}
+	}
+	if sep[0] == '[' {
+		_, err := io.WriteString(w, "[]")
+		return err
+	}
+	_, err := io.WriteString(w, "]")
+	return err
+}
@@ -0,0 +1,141 @@
+package jason
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestArrayReader(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    []string
+		wantErr error
+	}{
+		{in: `[]`, want: nil, wantErr: io.EOF},
+		{in: ` [1, "a", {"b": [2]}, null] `, want: []string{`1`, `"a"`, `{"b": [2]}`, `null`}, wantErr: io.EOF},
+		{in: `{"a": 1}`, wantErr: ErrNotArray},
+		{in: `1`, wantErr: ErrNotArray},
+		{in: `[1, 2`, want: []string{`1`, `2`}},
+		{in: `[1, }`, want: []string{`1`}},
+		{in: ``, wantErr: io.ErrUnexpectedEOF},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			ar := NewArrayReader(strings.NewReader(tt.in))
+			var got []string
+			var err error
+			for {
+				var j RawValue
+				if j, err = ar.Next(); err != nil {
+					break
+				}
+				got = append(got, string(j))
+			}
+			switch {
+			case tt.wantErr == nil:
+				if err == io.EOF {
+					t.Errorf("Next() error = %v, want a syntax error", err)
+				}
+			case !errors.Is(err, tt.wantErr):
+				t.Errorf("Next() error = %v, want %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Next() = %q, want %q", got, tt.want)
+			}
+			if _, again := ar.Next(); again != err {
+				t.Errorf("Next() after an error = %v, want %v", again, err)
+			}
+		})
+	}
+}
+
+func TestReadValue(t *testing.T) {
+	r := bufio.NewReader(strings.NewReader(` {"a": [1]}["x"]"s"12 true` + "\n34"))
+	for _, want := range []string{`{"a": [1]}`, `["x"]`, `"s"`, `12`, `true`, `34`} {
+		got, err := ReadValue(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != want {
+			t.Errorf("ReadValue() = %s, want %s", got, want)
+		}
+	}
+	if _, err := ReadValue(r); err != io.EOF {
+		t.Errorf("ReadValue() error = %v, want io.EOF", err)
+	}
+}
+
+func TestReadValue_rest(t *testing.T) {
+	// Without an io.ByteScanner, the data after a value is left in r,
+	// except for the delimiter after a number.
+	r := strings.NewReader(`{"a":1}tail`)
+	got, err := ReadValue(struct{ io.Reader }{r})
+	if err != nil || string(got) != `{"a":1}` {
+		t.Fatalf("ReadValue() = %s, %v", got, err)
+	}
+	if rest, _ := io.ReadAll(r); string(rest) != "tail" {
+		t.Errorf("ReadValue() left %q, want tail", rest)
+	}
+
+	r = strings.NewReader(`12,tail`)
+	got, err = ReadValue(struct{ io.Reader }{r})
+	if err != nil || string(got) != `12` {
+		t.Fatalf("ReadValue() = %s, %v", got, err)
+	}
+	if rest, _ := io.ReadAll(r); string(rest) != "tail" {
+		t.Errorf("ReadValue() left %q, want tail", rest)
+	}
+}
+
+func TestReadValue_errors(t *testing.T) {
+	for _, in := range []string{`{"a":`, `[1,]`, `}`} {
+		if _, err := ReadValue(strings.NewReader(in)); err == nil || err == io.EOF {
+			t.Errorf("ReadValue(%q) error = %v, want a syntax error", in, err)
+		}
+	}
+}
+
+func TestFilterStream(t *testing.T) {
+	even := func(j RawValue) (bool, error) {
+		n, err := AsA[int](j)
+		return n%2 == 0, err
+	}
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{in: `[1, 2, 3, 4]`, want: `[2,4]`},
+		{in: `[1, 3]`, want: `[]`},
+		{in: `[]`, want: `[]`},
+		{in: ` [ 2 ] `, want: `[2]`},
+		{in: `[2, "x"]`, wantErr: true},
+		{in: `[2, 4`, wantErr: true},
+		{in: `{"a": 2}`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			var buf strings.Builder
+			err := FilterStream(strings.NewReader(tt.in), &buf, even)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FilterStream() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && buf.String() != tt.want {
+				t.Errorf("FilterStream() = %s, want %s", buf.String(), tt.want)
+			}
+		})
+	}
+
+	errKeep := errors.New("keep failed")
+	err := FilterStream(strings.NewReader(`[1]`), io.Discard, func(RawValue) (bool, error) { return false, errKeep })
+	if err != errKeep {
+		t.Errorf("FilterStream() error = %v, want %v", err, errKeep)
+	}
+	if err := FilterStream(strings.NewReader(`[2]`), errWriter{}, even); err == nil {
+		t.Error("FilterStream() ignored a write error")
+	}
+}
@@ -0,0 +1,70 @@
+package jason
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// DecodeStrict unmarshals j into v, like [json.Unmarshal],
+// but rejects documents where any object, at any level,
+// has duplicate keys.
+//
+// Errors report the duplicate key, and the input offset
+// after which the duplicate was found.
+//
+// Example of decoding an untrusted token:
+//
+//	var claims map[string]any
+//	err := jason.DecodeStrict(j, &claims)
+func DecodeStrict(j RawValue, v any) error {
+	if err := checkDuplicates(j); err != nil {
+		return err
+	}
+	return json.Unmarshal(j, v)
+}
+
+// AsAStrict is like AsA, but fails if j has object keys
+// that do not match any non-ignored, exported fields of a struct.
+//
+// The error names the offending key.
+//
+// Example of rejecting unexpected fields in a request:
+//
+//	if req, err := jason.AsAStrict[Request](j); err == nil { ... }
+func AsAStrict[T any](j RawValue) (v T, err error) {
+	dec := json.NewDecoder(bytes.NewReader(j))
+	dec.DisallowUnknownFields()
+	err = decodeAll(dec, &v)
+	return v, err
+}
+
+func checkDuplicates(j RawValue) error {
+	var keys []map[string]struct{}
+	s := newTokenScanner(j)
+	for {
+		tok, key, depth, err := s.next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case key:
+			k := tok.(string)
+			if _, dup := keys[depth-1][k]; dup {
+				return fmt.Errorf("jason: duplicate key %q at offset %d", k, s.offset())
+			}
+			keys[depth-1][k] = struct{}{}
+		case tok == json.Delim('{'):
+			keys = append(keys, map[string]struct{}{})
+		case tok == json.Delim('['):
+			keys = append(keys, nil)
+		case tok == json.Delim('}') || tok == json.Delim(']'):
+			keys = keys[:depth]
+		}
+	}
+}
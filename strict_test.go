@@ -0,0 +1,85 @@
+package jason
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDecodeStrict(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    any
+		wantErr string
+	}{
+		{in: `{"a":1,"b":2}`, want: Object{"a": 1.0, "b": 2.0}},
+		{in: `{"a":{"x":1},"b":{"x":2}}`, want: Object{"a": Object{"x": 1.0}, "b": Object{"x": 2.0}}},
+		{in: `[{"a":1},{"a":2}]`, want: Array{Object{"a": 1.0}, Object{"a": 2.0}}},
+		{in: `{"a":1,"a":2}`, wantErr: `duplicate key "a"`},
+		{in: `{"a":{"b":1,"c":{},"b":2}}`, wantErr: `duplicate key "b"`},
+		{in: `[1,{"x":[],"x":null}]`, wantErr: `duplicate key "x"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			var got any
+			err := DecodeStrict(RawValue(tt.in), &got)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("DecodeStrict() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !equal(got, tt.want) {
+				t.Errorf("DecodeStrict() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	var got any
+	if err := DecodeStrict(RawValue(`{"a":1`), &got); err == nil {
+		t.Error("DecodeStrict() accepted invalid JSON")
+	}
+}
+
+func TestAsAStrict(t *testing.T) {
+	type user struct {
+		Name    string `json:"name"`
+		Ignored int    `json:"-"`
+		secret  int
+	}
+	tests := []struct {
+		in      string
+		want    user
+		wantErr string
+	}{
+		{in: `{"name":"Bob"}`, want: user{Name: "Bob"}},
+		{in: `{}`, want: user{}},
+		{in: `{"name":"Bob","age":3}`, wantErr: `"age"`},
+		{in: `{"name":"Bob","Ignored":1}`, wantErr: `"Ignored"`},
+		{in: `{"name":"Bob","secret":1}`, wantErr: `"secret"`},
+		{in: `{"name":"Bob"} {}`, wantErr: "after top-level value"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := AsAStrict[user](RawValue(tt.in))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("AsAStrict() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("AsAStrict() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+
+	// Maps have no unknown fields.
+	if _, err := AsAStrict[Object](RawValue(`{"a":1}`)); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,100 @@
+package jason
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Template substitutes the placeholders in the JSON template tmpl
+// by the values with the same name, and validates the result.
+//
+// Placeholders have the form ${name}, and are replaced by the
+// value of name in values, as raw JSON.
+// A placeholder can appear wherever a value is expected,
+// or as the whole of a string literal, including its quotes.
+// Placeholders within longer strings are an error,
+// as are placeholders with no value.
+//
+// Example of injecting a subtree into a literal document:
+//
+//	jason.Template(`{"user": ${user}, "tags": "${tags}"}`, jason.RawObject{
+//		"user": user,
+//		"tags": jason.From([]string{"a", "b"}),
+//	})
+func Template(tmpl string, values RawObject) (RawValue, error) {
+	var buf []byte
+	for i := 0; i < len(tmpl); {
+		switch {
+		case tmpl[i] == '"':
+			end := stringEnd(tmpl, i)
+			if end < 0 {
+				return nil, fmt.Errorf("jason: template: unterminated string at offset %d", i)
+			}
+			lit := tmpl[i:end]
+			if name, ok := placeholder(lit[1 : len(lit)-1]); ok {
+				v, err := templateValue(values, name)
+				if err != nil {
+					return nil, err
+				}
+				buf = append(buf, v...)
+			} else if strings.Contains(lit, "${") {
+				return nil, fmt.Errorf("jason: template: placeholder inside string %s", lit)
+			} else {
+				buf = append(buf, lit...)
+			}
+			i = end
+
+		case strings.HasPrefix(tmpl[i:], "${"):
+			end := strings.IndexByte(tmpl[i:], '}')
+			if end < 0 {
+				return nil, fmt.Errorf("jason: template: unterminated placeholder at offset %d", i)
+			}
+			v, err := templateValue(values, tmpl[i+2:i+end])
+			if err != nil {
+				return nil, err
+			}
+			buf = append(buf, v...)
+			i += end + 1
+
+		default:
+			buf = append(buf, tmpl[i])
+			i++
+		}
+	}
+
+	if err := Validate(buf); err != nil {
+		return nil, fmt.Errorf("jason: template: %w", err)
+	}
+	return buf, nil
+}
+
+// stringEnd returns the offset after the string literal starting at s[i],
+// or -1 if it is unterminated.
+func stringEnd(s string, i int) int {
+	for i++; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '"':
+			return i + 1
+		}
+	}
+	return -1
+}
+
+// placeholder returns the name of the placeholder s, if it is one.
+func placeholder(s string) (string, bool) {
+	if strings.HasPrefix(s, "${") && strings.HasSuffix(s, "}") {
+		name := s[2 : len(s)-1]
+		return name, !strings.ContainsAny(name, "{}")
+	}
+	return "", false
+}
+
+func templateValue(values RawObject, name string) (RawValue, error) {
+	v, ok := values[name]
+	if !ok {
+		return nil, fmt.Errorf("jason: template: no value for placeholder %q", name)
+	}
+	return v, nil
+}
@@ -0,0 +1,39 @@
+package jason
+
+import "testing"
+
+func TestTemplate(t *testing.T) {
+	values := RawObject{
+		"user": RawValue(`{"name":"Alice"}`),
+		"tags": RawValue(`["a","b"]`),
+		"n":    RawValue(`1`),
+	}
+	tests := []struct {
+		tmpl    string
+		want    string
+		wantErr bool
+	}{
+		{tmpl: `{"user": ${user}}`, want: `{"user": {"name":"Alice"}}`},
+		{tmpl: `{"tags": "${tags}"}`, want: `{"tags": ["a","b"]}`},
+		{tmpl: `[${n}, "${n}", "x"]`, want: `[1, 1, "x"]`},
+		{tmpl: `{"a\"${n}": 1}`, wantErr: true},
+		{tmpl: `"id-${n}"`, wantErr: true},
+		{tmpl: `${missing}`, wantErr: true},
+		{tmpl: `[${n`, wantErr: true},
+		{tmpl: `[${n},]`, wantErr: true},
+		{tmpl: `"`, wantErr: true},
+		{tmpl: `"\`, wantErr: true},
+		{tmpl: `["a\"]`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.tmpl, func(t *testing.T) {
+			got, err := Template(tt.tmpl, values)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Template() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Template() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package jason
+
+import (
+	"encoding/json"
+	"io"
+	"iter"
+	"strconv"
+)
+
+// Token is a JSON token, as yielded by Tokens.
+type Token struct {
+	// Kind is the kind of the value the token belongs to:
+	// ObjectKind and ArrayKind for delimiters, StringKind for keys.
+	Kind Kind
+	// Value is the token: a [json.Delim] for the start or end of an object or array,
+	// a string, a Number, a bool, or nil.
+	Value json.Token
+	// Key reports whether the token is an object key.
+	Key bool
+	// Path is the JSON Pointer to the value the token belongs to,
+	// or to the member the key names.
+	Path string
+}
+
+// Tokens returns an iterator over the tokens of j,
+// walking it without building a tree.
+//
+// Iteration stops after the first error.
+//
+// Example of listing the keys of a large document:
+//
+//	for tok, err := range jason.Tokens(j) {
+//		if err != nil { ... }
+//		if tok.Key { fmt.Println(tok.Path) }
+//	}
+func Tokens(j RawValue) iter.Seq2[Token, error] {
+	return func(yield func(Token, error) bool) {
+		var path []string
+		var index []int // next array index, or -1 for objects
+
+		s := newTokenScanner(j)
+		for {
+			t, key, depth, err := s.next()
+			if err == io.EOF {
+				return
+			}
+			if err != nil {
+				yield(Token{}, err)
+				return
+			}
+
+			var kind Kind
+			switch t {
+			case json.Delim('}'), json.Delim(']'):
+				path, index = path[:depth], index[:depth]
+			default:
+				if key {
+					path[depth-1] = t.(string)
+				} else if depth > 0 && index[depth-1] >= 0 {
+					path[depth-1] = strconv.Itoa(index[depth-1])
+					index[depth-1]++
+				}
+			}
+
+			switch t := t.(type) {
+			case json.Delim:
+				if t == '{' || t == '}' {
+					kind = ObjectKind
+				} else {
+					kind = ArrayKind
+				}
+			case string:
+				kind = StringKind
+			case Number:
+				kind = NumberKind
+			case bool:
+				kind = BoolKind
+			case nil:
+				kind = NullKind
+			}
+
+			tok := Token{Kind: kind, Value: t, Key: key, Path: JoinPointer(path...)}
+			if !yield(tok, nil) {
+				return
+			}
+
+			switch t {
+			case json.Delim('{'):
+				path, index = append(path, ""), append(index, -1)
+			case json.Delim('['):
+				path, index = append(path, ""), append(index, 0)
+			}
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package jason
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestTokens(t *testing.T) {
+	j := RawValue(`{"a": [1, {"b": null}], "c": true, "d/e": "s"}`)
+	want := []Token{
+		{ObjectKind, json.Delim('{'), false, ""},
+		{StringKind, "a", true, "/a"},
+		{ArrayKind, json.Delim('['), false, "/a"},
+		{NumberKind, Number("1"), false, "/a/0"},
+		{ObjectKind, json.Delim('{'), false, "/a/1"},
+		{StringKind, "b", true, "/a/1/b"},
+		{NullKind, nil, false, "/a/1/b"},
+		{ObjectKind, json.Delim('}'), false, "/a/1"},
+		{ArrayKind, json.Delim(']'), false, "/a"},
+		{StringKind, "c", true, "/c"},
+		{BoolKind, true, false, "/c"},
+		{StringKind, "d/e", true, "/d~1e"},
+		{StringKind, "s", false, "/d~1e"},
+		{ObjectKind, json.Delim('}'), false, ""},
+	}
+	var got []Token
+	for tok, err := range Tokens(j) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, tok)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Tokens() = %v, want %v", got, want)
+	}
+}
+
+func TestTokens_scalar(t *testing.T) {
+	var got []Token
+	for tok, err := range Tokens(RawValue(` "s" `)) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, tok)
+	}
+	if want := []Token{{StringKind, "s", false, ""}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Tokens() = %v, want %v", got, want)
+	}
+}
+
+func TestTokens_errors(t *testing.T) {
+	for _, in := range []string{``, `[1,`, `[1] 2`, `{"a" 1}`} {
+		var n int
+		var last error
+		for _, err := range Tokens(RawValue(in)) {
+			n++
+			last = err
+		}
+		if last == nil {
+			t.Errorf("Tokens(%q) did not end with an error, after %d tokens", in, n)
+		}
+	}
+}
+
+func TestTokens_break(t *testing.T) {
+	var n int
+	for range Tokens(RawValue(`[1, 2, 3`)) {
+		if n++; n == 2 {
+			break
+		}
+	}
+	if n != 2 {
+		t.Errorf("Tokens() yielded %d tokens after break, want 2", n)
+	}
+}
@@ -0,0 +1,127 @@
+package jason
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+)
+
+var errTrailingData = errors.New("jason: invalid data after top-level value")
+
+// AsTree decodes j into a tree of
+// Object, Array, Number, string, bool and nil values.
+//
+// Unlike unmarshaling into an any, numbers are decoded as Number,
+// so large integers keep their precision.
+// The tree can be used with Walk, Flatten, Clone, etc.
+//
+// Example of decoding a document with 64-bit IDs:
+//
+//	tree, err := jason.AsTree(j)
+func AsTree(j RawValue) (any, error) {
+	return decodeTree(j)
+}
+
+// AtTree resolves the JSON Pointer ptr against the decoded tree v,
+// like Pointer does against a RawValue,
+// and returns the value found, and whether it was found.
+//
+// Any failure to resolve ptr, including a malformed pointer,
+// returns nil and false.
+//
+// Example of getting the name of the first user from a tree:
+//
+//	name, ok := jason.AtTree(tree, "/users/0/name")
+func AtTree(v any, ptr string) (any, bool) {
+	path, err := SplitPointer(ptr)
+	if err != nil {
+		return nil, false
+	}
+	v, err = getTree(v, path)
+	if err != nil {
+		return nil, false
+	}
+	return v, true
+}
+
+// decodeTree decodes j into a tree of
+// Object, Array, Number, string, bool and nil values.
+func decodeTree(j RawValue) (v any, err error) {
+	dec := json.NewDecoder(bytes.NewReader(j))
+	dec.UseNumber()
+	err = decodeAll(dec, &v)
+	return v, err
+}
+
+// decodeAll decodes the single value read by dec into v,
+// rejecting any trailing data.
+func decodeAll(dec *json.Decoder, v any) error {
+	if err := dec.Decode(v); err != nil {
+		return unexpectedEOF(err)
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		if err == nil {
+			err = errTrailingData
+		}
+		return err
+	}
+	return nil
+}
+
+// AsTreeShallow decodes j into a tree, like AsTree,
+// but only down to maxDepth levels of nesting:
+// values nested deeper are left in the tree as undecoded RawValues.
+//
+// With maxDepth 1, j is decoded as an Object or Array of RawValues;
+// with maxDepth 0 or less, j is returned as a RawValue, once validated.
+// A RawValue in the tree can itself be decoded with AsTreeShallow on demand.
+//
+// Example of decoding the top two levels of a huge document:
+//
+//	tree, err := jason.AsTreeShallow(j, 2)
+func AsTreeShallow(j RawValue, maxDepth int) (any, error) {
+	var raw RawValue
+	dec := json.NewDecoder(bytes.NewReader(j))
+	if err := decodeAll(dec, &raw); err != nil {
+		return nil, err
+	}
+	return shallowTree(raw, maxDepth)
+}
+
+func shallowTree(j RawValue, depth int) (any, error) {
+	if depth <= 0 {
+		return j, nil
+	}
+	switch kindOf(j) {
+	case ObjectKind:
+		var obj RawObject
+		if err := json.Unmarshal(j, &obj); err != nil {
+			return nil, err
+		}
+		res := make(Object, len(obj))
+		for k, v := range obj {
+			t, err := shallowTree(v, depth-1)
+			if err != nil {
+				return nil, err
+			}
+			res[k] = t
+		}
+		return res, nil
+	case ArrayKind:
+		var arr RawArray
+		if err := json.Unmarshal(j, &arr); err != nil {
+			return nil, err
+		}
+		res := make(Array, len(arr))
+		for i, v := range arr {
+			t, err := shallowTree(v, depth-1)
+			if err != nil {
+				return nil, err
+			}
+			res[i] = t
+		}
+		return res, nil
+	}
+	return decodeTree(j)
+}
@@ -0,0 +1,106 @@
+package jason
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAsTree(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    any
+		wantErr bool
+	}{
+		{in: `null`, want: nil},
+		{in: `"s"`, want: "s"},
+		{in: `true`, want: true},
+		{in: `12345678901234567890`, want: Number("12345678901234567890")},
+		{in: ` 1.50 `, want: Number("1.50")},
+		{in: `{"a":[1,{"b":null}]}`, want: Object{"a": Array{Number("1"), Object{"b": nil}}}},
+		{in: `[]`, want: Array{}},
+		{in: ``, wantErr: true},
+		{in: `[1,`, wantErr: true},
+		{in: `{} {}`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := AsTree(RawValue(tt.in))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("AsTree() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AsTree() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAsTreeShallow(t *testing.T) {
+	j := RawValue(`{"a": {"b": [1, {"c": 2}]}, "d": [3], "e": "s"}`)
+	tests := []struct {
+		depth int
+		want  any
+	}{
+		{0, j},
+		{1, Object{"a": RawValue(`{"b": [1, {"c": 2}]}`), "d": RawValue(`[3]`), "e": RawValue(`"s"`)}},
+		{2, Object{
+			"a": Object{"b": RawValue(`[1, {"c": 2}]`)},
+			"d": Array{RawValue(`3`)},
+			"e": "s",
+		}},
+		{4, Object{
+			"a": Object{"b": Array{Number("1"), Object{"c": RawValue(`2`)}}},
+			"d": Array{Number("3")},
+			"e": "s",
+		}},
+		{5, Object{
+			"a": Object{"b": Array{Number("1"), Object{"c": Number("2")}}},
+			"d": Array{Number("3")},
+			"e": "s",
+		}},
+	}
+	for _, tt := range tests {
+		got, err := AsTreeShallow(j, tt.depth)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("AsTreeShallow(%d) = %#v, want %#v", tt.depth, got, tt.want)
+		}
+	}
+
+	if got, err := AsTreeShallow(RawValue(` 1 `), 1); err != nil || got != Number("1") {
+		t.Errorf("AsTreeShallow() = %#v, %v, want 1", got, err)
+	}
+	for _, in := range []string{``, `{"a":[}`, `[1] 2`} {
+		if _, err := AsTreeShallow(RawValue(in), 0); err == nil {
+			t.Errorf("AsTreeShallow(%q) accepted invalid JSON", in)
+		}
+	}
+}
+
+func TestAtTree(t *testing.T) {
+	tree := Object{"users": Array{Object{"name": "Bob", "tags": nil}}, "a/b": Number("1")}
+	tests := []struct {
+		ptr  string
+		want any
+		ok   bool
+	}{
+		{"", tree, true},
+		{"/users/0/name", "Bob", true},
+		{"/users/0/tags", nil, true},
+		{"/a~1b", Number("1"), true},
+		{"/users/1", nil, false},
+		{"/users/-", nil, false},
+		{"/users/x", nil, false},
+		{"/users/0/name/x", nil, false},
+		{"/missing", nil, false},
+		{"users", nil, false},
+	}
+	for _, tt := range tests {
+		got, ok := AtTree(tree, tt.ptr)
+		if ok != tt.ok || !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("AtTree(%q) = %v, %v, want %v, %v", tt.ptr, got, ok, tt.want, tt.ok)
+		}
+	}
+}
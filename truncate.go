@@ -0,0 +1,86 @@
+package jason
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+	"unicode/utf8"
+)
+
+// TruncateOptions sets the limits applied by Truncate.
+//
+// A zero limit uses the default, a negative one disables it.
+type TruncateOptions struct {
+	MaxString int // maximum length of strings, in runes (default 256)
+	MaxArray  int // maximum number of array elements (default 100)
+	MaxKeys   int // maximum number of object keys (default 100)
+}
+
+// Truncate returns a shortened copy of j, suitable for logging.
+//
+// Strings longer than the limit are cut, and end with "…".
+// Arrays keep their first elements, followed by a string
+// noting how many were dropped, like "… 5 more".
+// Objects keep their first keys, in sorted order,
+// and note how many were dropped under the key "…".
+// The result is valid JSON, with object keys sorted.
+//
+// Example of logging an untrusted payload:
+//
+//	short, err := jason.Truncate(j, jason.TruncateOptions{MaxString: 64})
+func Truncate(j RawValue, opts TruncateOptions) (RawValue, error) {
+	tree, err := decodeTree(j)
+	if err != nil {
+		return nil, err
+	}
+	opts.MaxString = truncateLimit(opts.MaxString, 256)
+	opts.MaxArray = truncateLimit(opts.MaxArray, 100)
+	opts.MaxKeys = truncateLimit(opts.MaxKeys, 100)
+	return json.Marshal(truncateTree(tree, &opts))
+}
+
+func truncateLimit(n, def int) int {
+	if n == 0 {
+		return def
+	}
+	return n
+}
+
+func truncateTree(v any, opts *TruncateOptions) any {
+	switch v := v.(type) {
+	case string:
+		if opts.MaxString >= 0 && utf8.RuneCountInString(v) > opts.MaxString {
+			i, n := 0, 0
+			for ; n < opts.MaxString; n++ {
+				_, size := utf8.DecodeRuneInString(v[i:])
+				i += size
+			}
+			return v[:i] + "…"
+		}
+	case Array:
+		res := make(Array, 0, len(v))
+		for i, e := range v {
+			if opts.MaxArray >= 0 && i >= opts.MaxArray {
+				return append(res, "… "+strconv.Itoa(len(v)-i)+" more")
+			}
+			res = append(res, truncateTree(e, opts))
+		}
+		return res
+	case Object:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		res := make(Object, len(v))
+		for i, k := range keys {
+			if opts.MaxKeys >= 0 && i >= opts.MaxKeys {
+				res["…"] = strconv.Itoa(len(v)-i) + " more"
+				break
+			}
+			res[k] = truncateTree(v[k], opts)
+		}
+		return res
+	}
+	return v
+}
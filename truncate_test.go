@@ -0,0 +1,50 @@
+package jason
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTruncate(t *testing.T) {
+	long := `"` + strings.Repeat("é", 300) + `"`
+	tests := []struct {
+		name string
+		in   string
+		opts TruncateOptions
+		want string
+	}{
+		{"short", `{"a":"abc","b":[1,2]}`, TruncateOptions{}, `{"a":"abc","b":[1,2]}`},
+		{"string", `"abcdef"`, TruncateOptions{MaxString: 3}, `"abc…"`},
+		{"runes", `"ñññ"`, TruncateOptions{MaxString: 2}, `"ññ…"`},
+		{"exact", `"abc"`, TruncateOptions{MaxString: 3}, `"abc"`},
+		{"default string", long, TruncateOptions{}, `"` + strings.Repeat("é", 256) + `…"`},
+		{"no string limit", long, TruncateOptions{MaxString: -1}, long},
+		{"array", `[1,2,3,4,5]`, TruncateOptions{MaxArray: 2}, `[1,2,"… 3 more"]`},
+		{"object", `{"d":1,"c":2,"b":3,"a":4}`, TruncateOptions{MaxKeys: 1}, `{"a":4,"…":"3 more"}`},
+		{"nested", `{"a":[{"s":"xyz"},2,3]}`, TruncateOptions{MaxString: 1, MaxArray: 1}, `{"a":[{"s":"x…"},"… 2 more"]}`},
+		{"no limits", `[[1,2],{"a":1,"b":2}]`, TruncateOptions{MaxArray: -1, MaxKeys: -1}, `[[1,2],{"a":1,"b":2}]`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Truncate(RawValue(tt.in), tt.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Truncate() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	big := "[" + strings.Repeat("0,", 150) + "0]"
+	got, err := Truncate(RawValue(big), TruncateOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "[" + strings.Repeat("0,", 100) + `"… 51 more"]`; string(got) != want {
+		t.Errorf("Truncate() = %s, want %s", got, want)
+	}
+	if _, err := Truncate(RawValue(`[`), TruncateOptions{}); err == nil {
+		t.Error("Truncate() accepted invalid JSON")
+	}
+}
@@ -0,0 +1,131 @@
+package jason
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// AsArrayOf unmarshals the array j into a slice of values of type T.
+//
+// An error unmarshaling an element reports the index of the element.
+// A JSON null unmarshals into a nil slice.
+//
+// Example of converting j into a slice of time instants:
+//
+//	if v, err := jason.AsArrayOf[time.Time](j); err == nil { ... }
+func AsArrayOf[T any](j RawValue) ([]T, error) {
+	var arr RawArray
+	if err := json.Unmarshal(j, &arr); err != nil {
+		return nil, err
+	}
+	if arr == nil {
+		return nil, nil
+	}
+	s := make([]T, len(arr))
+	for i, e := range arr {
+		if err := json.Unmarshal(e, &s[i]); err != nil {
+			return nil, fmt.Errorf("jason: index %d: %w", i, err)
+		}
+	}
+	return s, nil
+}
+
+// AsObjectOf unmarshals the object j into a map of values of type T.
+//
+// An error unmarshaling a value reports the key of the value.
+// A JSON null unmarshals into a nil map.
+//
+// Example of converting j into a map of time instants:
+//
+//	if v, err := jason.AsObjectOf[time.Time](j); err == nil { ... }
+func AsObjectOf[T any](j RawValue) (map[string]T, error) {
+	var obj RawObject
+	if err := json.Unmarshal(j, &obj); err != nil {
+		return nil, err
+	}
+	if obj == nil {
+		return nil, nil
+	}
+	m := make(map[string]T, len(obj))
+	for k, e := range obj {
+		var v T
+		if err := json.Unmarshal(e, &v); err != nil {
+			return nil, fmt.Errorf("jason: key %q: %w", k, err)
+		}
+		m[k] = v
+	}
+	return m, nil
+}
+
+// WhichType returns the first of candidates that j can be unmarshaled into,
+// and whether there was one.
+//
+// Candidates are tried in order, as by IsA,
+// and later candidates are not tried after a match,
+// so ambiguous input picks the first match;
+// a JSON null, which unmarshals into anything, picks the first candidate.
+// Note that any JSON object can be unmarshaled into a struct,
+// unless the struct implements [json.Unmarshaler] to reject it,
+// so candidates should be ordered from most to least specific.
+//
+// Example of dispatching on the shape of a polymorphic field:
+//
+//	switch typ, _ := jason.WhichType(j, reflect.TypeOf(""), reflect.TypeOf([]string{})); typ { ... }
+func WhichType(j RawValue, candidates ...reflect.Type) (reflect.Type, bool) {
+	for _, typ := range candidates {
+		v := reflect.New(typ)
+		if json.Unmarshal(j, v.Interface()) == nil {
+			return typ, true
+		}
+	}
+	return nil, false
+}
+
+// DefaultCase is the key of the fallback case of Dispatch.
+const DefaultCase = "*"
+
+// Dispatch reads the string discriminator at key field of the object j,
+// and calls the function of cases for its value, with j.
+//
+// If no case matches, or j has no field,
+// the function for DefaultCase is called, if there is one;
+// otherwise, the error wraps [ErrNotFound] and reports the discriminator.
+// Errors returned by the called function are returned as is.
+//
+// Example of decoding a polymorphic event:
+//
+//	ev, err := jason.Dispatch(j, "type", map[string]func(jason.RawValue) (Event, error){
+//		"click": decodeClick,
+//		"key":   decodeKey,
+//	})
+func Dispatch[T any](j RawValue, field string, cases map[string]func(RawValue) (T, error)) (T, error) {
+	var zero T
+	var obj RawObject
+	if err := json.Unmarshal(j, &obj); err != nil {
+		return zero, fmt.Errorf("jason: %w", typeError(err, ErrNotObject))
+	}
+	if obj == nil {
+		return zero, fmt.Errorf("jason: %w", ErrNotObject)
+	}
+
+	disc, found := obj[field]
+	var tag string
+	if found {
+		if err := json.Unmarshal(disc, &tag); err != nil || isNull(disc) {
+			return zero, fmt.Errorf("jason: key %q: discriminator is %s, not a string", field, KindOf(disc))
+		}
+	}
+
+	fn, ok := cases[DefaultCase]
+	if f, match := cases[tag]; found && match {
+		fn, ok = f, true
+	}
+	if !ok {
+		if !found {
+			return zero, fmt.Errorf("jason: key %q: %w", field, ErrNotFound)
+		}
+		return zero, fmt.Errorf("jason: %s %q: %w", field, tag, ErrNotFound)
+	}
+	return fn(j)
+}
@@ -0,0 +1,159 @@
+package jason
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestAsArrayOf(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    []int
+		wantErr string
+	}{
+		{in: `[1, 2, 3]`, want: []int{1, 2, 3}},
+		{in: `[]`, want: []int{}},
+		{in: `null`, want: nil},
+		{in: `[1, "x", 3]`, wantErr: "index 1"},
+		{in: `{"a": 1}`, wantErr: "cannot unmarshal"},
+		{in: `[1,`, wantErr: "unexpected end"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := AsArrayOf[int](RawValue(tt.in))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("AsArrayOf() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AsArrayOf() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAsObjectOf(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    map[string]int
+		wantErr string
+	}{
+		{in: `{"a": 1, "b": 2}`, want: map[string]int{"a": 1, "b": 2}},
+		{in: `{}`, want: map[string]int{}},
+		{in: `null`, want: nil},
+		{in: `{"a": 1, "b": true}`, wantErr: `key "b"`},
+		{in: `[1]`, wantErr: "cannot unmarshal"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := AsObjectOf[int](RawValue(tt.in))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("AsObjectOf() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AsObjectOf() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWhichType(t *testing.T) {
+	var (
+		str    = reflect.TypeOf("")
+		num    = reflect.TypeOf(0.0)
+		strs   = reflect.TypeOf([]string{})
+		object = reflect.TypeOf(Object{})
+	)
+	tests := []struct {
+		in   string
+		cand []reflect.Type
+		want reflect.Type
+	}{
+		{`"s"`, []reflect.Type{num, str, strs}, str},
+		{`1`, []reflect.Type{str, num}, num},
+		{`["a"]`, []reflect.Type{str, strs}, strs},
+		{`[1]`, []reflect.Type{str, strs}, nil},
+		{`{}`, []reflect.Type{strs, object}, object},
+		{`null`, []reflect.Type{num, str}, num},
+		{`"s"`, nil, nil},
+		{`[`, []reflect.Type{strs}, nil},
+	}
+	for _, tt := range tests {
+		got, ok := WhichType(RawValue(tt.in), tt.cand...)
+		if got != tt.want || ok != (tt.want != nil) {
+			t.Errorf("WhichType(%s) = %v, %v, want %v", tt.in, got, ok, tt.want)
+		}
+	}
+}
+
+func TestDispatch(t *testing.T) {
+	decode := func(kind string) func(RawValue) (string, error) {
+		return func(j RawValue) (string, error) {
+			return kind + ":" + string(j), nil
+		}
+	}
+	cases := map[string]func(RawValue) (string, error){
+		"click": decode("click"),
+		"key":   decode("key"),
+	}
+	withDefault := map[string]func(RawValue) (string, error){
+		"click":     decode("click"),
+		DefaultCase: decode("default"),
+	}
+
+	tests := []struct {
+		in      string
+		cases   map[string]func(RawValue) (string, error)
+		want    string
+		wantErr error
+	}{
+		{in: `{"type":"click","x":1}`, cases: cases, want: `click:{"type":"click","x":1}`},
+		{in: `{"type":"key"}`, cases: cases, want: `key:{"type":"key"}`},
+		{in: `{"type":"drag"}`, cases: cases, wantErr: ErrNotFound},
+		{in: `{"x":1}`, cases: cases, wantErr: ErrNotFound},
+		{in: `{"type":"drag"}`, cases: withDefault, want: `default:{"type":"drag"}`},
+		{in: `{"x":1}`, cases: withDefault, want: `default:{"x":1}`},
+		{in: `{"type":"*"}`, cases: cases, wantErr: ErrNotFound},
+		{in: `[1]`, cases: withDefault, wantErr: ErrNotObject},
+		{in: `null`, cases: withDefault, wantErr: ErrNotObject},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := Dispatch(RawValue(tt.in), "type", tt.cases)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Dispatch() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Dispatch() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	for _, in := range []string{`{"type":1}`, `{"type":null}`, `{"type":["click"]}`} {
+		_, err := Dispatch(RawValue(in), "type", withDefault)
+		if err == nil || !strings.Contains(err.Error(), "not a string") {
+			t.Errorf("Dispatch(%s) error = %v, want not a string", in, err)
+		}
+	}
+
+	errFn := errors.New("fn failed")
+	_, err := Dispatch(RawValue(`{"type":"a"}`), "type", map[string]func(RawValue) (int, error){
+		"a": func(RawValue) (int, error) { return 0, errFn },
+	})
+	if err != errFn {
+		t.Errorf("Dispatch() error = %v, want %v", err, errFn)
+	}
+}
@@ -0,0 +1,42 @@
+package jason
+
+import "encoding/json"
+
+// Update returns a copy of doc with each value at a path
+// matching pattern replaced by the result of calling fn on it.
+//
+// The pattern is as for Query.
+// Values nested in a matching value are not visited.
+// If fn returns an error, Update stops and returns it.
+// The input document is not modified.
+//
+// Example of converting every item price:
+//
+//	jason.Update(doc, "/items/*/price", func(j jason.RawValue) (jason.RawValue, error) {
+//		p, err := jason.AsA[float64](j)
+//		return jason.From(p * rate), err
+//	})
+func Update(doc RawValue, pattern string, fn func(RawValue) (RawValue, error)) (RawValue, error) {
+	m, err := newMatcher([]string{pattern})
+	if err != nil {
+		return nil, err
+	}
+	tree, err := decodeTree(doc)
+	if err != nil {
+		return nil, err
+	}
+	tree, err = m.replaceTree(tree, m.start(), func(v any) (any, error) {
+		j, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		if j, err = fn(j); err != nil {
+			return nil, err
+		}
+		return decodeTree(j)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(tree)
+}
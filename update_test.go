@@ -0,0 +1,56 @@
+package jason
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestUpdate(t *testing.T) {
+	doc := RawValue(`{"items":[{"price":10},{"price":2.5},{"name":"x"}],"meta":{"price":1}}`)
+	double := func(j RawValue) (RawValue, error) {
+		p, err := AsA[float64](j)
+		return From(p * 2), err
+	}
+	wrap := func(j RawValue) (RawValue, error) {
+		return From(Array{j}), nil
+	}
+	tests := []struct {
+		name    string
+		pattern string
+		fn      func(RawValue) (RawValue, error)
+		want    string
+	}{
+		{"star", "/items/*/price", double, `{"items":[{"price":20},{"price":5},{"name":"x"}],"meta":{"price":1}}`},
+		{"globstar", "/**/price", double, `{"items":[{"price":20},{"price":5},{"name":"x"}],"meta":{"price":2}}`},
+		{"missing", "/nothing", double, string(doc)},
+		{"outer only", "/**", wrap, `[` + string(doc) + `]`},
+		{"subtree", "/meta", wrap, `{"items":[{"price":10},{"price":2.5},{"name":"x"}],"meta":[{"price":1}]}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Update(doc, tt.pattern, tt.fn)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !Equal(got, RawValue(tt.want)) {
+				t.Errorf("Update() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	errFn := errors.New("fn failed")
+	_, err := Update(doc, "/items/*", func(RawValue) (RawValue, error) { return nil, errFn })
+	if !errors.Is(err, errFn) {
+		t.Errorf("Update() error = %v, want %v", err, errFn)
+	}
+	_, err = Update(doc, "/items/*", func(RawValue) (RawValue, error) { return RawValue(`[`), nil })
+	if err == nil {
+		t.Error("Update() accepted an invalid replacement")
+	}
+	if _, err := Update(doc, "items", double); err == nil {
+		t.Error("Update() accepted an invalid pattern")
+	}
+	if _, err := Update(RawValue(`{`), "/a", double); err == nil {
+		t.Error("Update() accepted invalid JSON")
+	}
+}
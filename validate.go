@@ -0,0 +1,25 @@
+package jason
+
+import "encoding/json"
+
+// Valid reports whether j is a valid JSON encoding.
+func Valid(j RawValue) bool {
+	return json.Valid(j)
+}
+
+// Validate checks whether j is a valid JSON encoding.
+//
+// It returns a [*json.SyntaxError] with the byte offset of the failure,
+// including for any trailing data after a complete value.
+//
+// Example of reporting the position of a syntax error:
+//
+//	var serr *json.SyntaxError
+//	if err := jason.Validate(j); errors.As(err, &serr) { ... serr.Offset ... }
+func Validate(j RawValue) error {
+	if json.Valid(j) {
+		return nil
+	}
+	var v RawValue
+	return json.Unmarshal(j, &v)
+}
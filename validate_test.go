@@ -0,0 +1,45 @@
+package jason
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		in     string
+		valid  bool
+		offset int64
+	}{
+		{in: `{"a":[1,true,null,"x"]}`, valid: true},
+		{in: ` 1 `, valid: true},
+		{in: ``, offset: 0},
+		{in: `{`, offset: 1},
+		{in: `[1,]`, offset: 4},
+		{in: `{"a" 1}`, offset: 6},
+		{in: `1 2`, offset: 3},
+		{in: `nul`, offset: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			if got := Valid(RawValue(tt.in)); got != tt.valid {
+				t.Errorf("Valid() = %v, want %v", got, tt.valid)
+			}
+			err := Validate(RawValue(tt.in))
+			if tt.valid {
+				if err != nil {
+					t.Errorf("Validate() error = %v", err)
+				}
+				return
+			}
+			var serr *json.SyntaxError
+			if !errors.As(err, &serr) {
+				t.Fatalf("Validate() error = %v, want a *json.SyntaxError", err)
+			}
+			if serr.Offset != tt.offset {
+				t.Errorf("Validate() offset = %d, want %d", serr.Offset, tt.offset)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package jason
+
+import "math"
+
+// Value wraps a node of a decoded tree,
+// as returned by AsTree, for safe navigation.
+//
+// Accessors never panic: they report whether the node has the
+// requested type, and navigating to a missing node returns an
+// invalid Value, on which every accessor fails.
+//
+// Example of reading an optional, nested field:
+//
+//	tree, err := jason.AsTree(j)
+//	name, ok := jason.ValueOf(tree).Get("users").Index(0).Get("name").String()
+type Value struct {
+	node  any
+	valid bool
+}
+
+// ValueOf returns a Value wrapping the decoded node v.
+func ValueOf(v any) Value {
+	return Value{v, true}
+}
+
+// Valid reports whether v was found.
+func (v Value) Valid() bool {
+	return v.valid
+}
+
+// IsNull reports whether v is a JSON null.
+func (v Value) IsNull() bool {
+	return v.valid && v.node == nil
+}
+
+// Any returns the wrapped node, and whether v is valid.
+func (v Value) Any() (any, bool) {
+	return v.node, v.valid
+}
+
+// String returns v as a string, if it is a JSON string.
+func (v Value) String() (string, bool) {
+	s, ok := v.node.(string)
+	return s, ok
+}
+
+// Int returns v as an int64, if it is a JSON number
+// with an integer value that fits an int64.
+func (v Value) Int() (int64, bool) {
+	switch n := v.node.(type) {
+	case Number:
+		i, err := ToBigInt(n)
+		if err == nil && i.IsInt64() {
+			return i.Int64(), true
+		}
+	case float64:
+		if n == math.Trunc(n) && -(1<<63) <= n && n < 1<<63 {
+			return int64(n), true
+		}
+	}
+	return 0, false
+}
+
+// Float returns v as a float64, if it is a JSON number.
+func (v Value) Float() (float64, bool) {
+	switch n := v.node.(type) {
+	case Number:
+		f, err := n.Float64()
+		return f, err == nil
+	case float64:
+		return n, true
+	}
+	return 0, false
+}
+
+// Bool returns v as a bool, if it is a JSON boolean.
+func (v Value) Bool() (bool, bool) {
+	b, ok := v.node.(bool)
+	return b, ok
+}
+
+// Array returns v as an Array, if it is a JSON array.
+func (v Value) Array() (Array, bool) {
+	a, ok := v.node.(Array)
+	return a, ok
+}
+
+// Object returns v as an Object, if it is a JSON object.
+func (v Value) Object() (Object, bool) {
+	o, ok := v.node.(Object)
+	return o, ok
+}
+
+// Get returns the value of key, if v is an object with that key,
+// or an invalid Value.
+func (v Value) Get(key string) Value {
+	if o, ok := v.node.(Object); ok {
+		if e, ok := o[key]; ok {
+			return Value{e, true}
+		}
+	}
+	return Value{}
+}
+
+// Index returns the element at index i, if v is an array with that index,
+// or an invalid Value.
+func (v Value) Index(i int) Value {
+	if a, ok := v.node.(Array); ok && 0 <= i && i < len(a) {
+		return Value{a[i], true}
+	}
+	return Value{}
+}
@@ -0,0 +1,109 @@
+package jason
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestValue(t *testing.T) {
+	tree, err := AsTree(RawValue(`{"users":[{"name":"Bob","age":42,"admin":true,"score":1.5,"big":12345678901234567890,"nick":null}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	root := ValueOf(tree)
+	user := root.Get("users").Index(0)
+
+	if !user.Valid() || user.IsNull() {
+		t.Fatalf("Get().Index() = %v, want a valid value", user)
+	}
+	if s, ok := user.Get("name").String(); !ok || s != "Bob" {
+		t.Errorf("String() = %q, %v, want Bob", s, ok)
+	}
+	if i, ok := user.Get("age").Int(); !ok || i != 42 {
+		t.Errorf("Int() = %d, %v, want 42", i, ok)
+	}
+	if f, ok := user.Get("score").Float(); !ok || f != 1.5 {
+		t.Errorf("Float() = %g, %v, want 1.5", f, ok)
+	}
+	if b, ok := user.Get("admin").Bool(); !ok || !b {
+		t.Errorf("Bool() = %v, %v, want true", b, ok)
+	}
+	if _, ok := user.Get("score").Int(); ok {
+		t.Error("Int() accepted a fraction")
+	}
+	if _, ok := user.Get("big").Int(); ok {
+		t.Error("Int() accepted a number that overflows int64")
+	}
+	if _, ok := user.Get("name").Int(); ok {
+		t.Error("Int() accepted a string")
+	}
+	if _, ok := user.Get("age").String(); ok {
+		t.Error("String() accepted a number")
+	}
+
+	nick := user.Get("nick")
+	if !nick.Valid() || !nick.IsNull() {
+		t.Error("IsNull() = false for a null")
+	}
+	if _, ok := nick.String(); ok {
+		t.Error("String() accepted a null")
+	}
+
+	if a, ok := root.Get("users").Array(); !ok || len(a) != 1 {
+		t.Errorf("Array() = %v, %v", a, ok)
+	}
+	if o, ok := user.Object(); !ok || len(o) != 6 {
+		t.Errorf("Object() = %v, %v", o, ok)
+	}
+	if v, ok := user.Get("admin").Any(); !ok || v != true {
+		t.Errorf("Any() = %v, %v, want true", v, ok)
+	}
+}
+
+func TestValue_missing(t *testing.T) {
+	root := ValueOf(Object{"a": Array{1.0}})
+	for _, v := range []Value{
+		root.Get("b"),
+		root.Index(0),
+		root.Get("a").Get("x"),
+		root.Get("a").Index(1),
+		root.Get("a").Index(-1),
+		root.Get("b").Get("c").Index(2),
+	} {
+		if v.Valid() || v.IsNull() {
+			t.Errorf("Valid() = %v, IsNull() = %v, want false", v.Valid(), v.IsNull())
+		}
+		if n, ok := v.Any(); ok || n != nil {
+			t.Errorf("Any() = %v, %v, want nil, false", n, ok)
+		}
+		if _, ok := v.Float(); ok {
+			t.Error("Float() of a missing value succeeded")
+		}
+		if _, ok := v.Object(); ok {
+			t.Error("Object() of a missing value succeeded")
+		}
+	}
+	if !ValueOf(nil).IsNull() {
+		t.Error("ValueOf(nil).IsNull() = false")
+	}
+}
+
+func TestValue_float64(t *testing.T) {
+	// Trees decoded by json.Unmarshal hold float64 numbers.
+	v := ValueOf(Array{3.0, 2.5, 1e19})
+	if i, ok := v.Index(0).Int(); !ok || i != 3 {
+		t.Errorf("Int() = %d, %v, want 3", i, ok)
+	}
+	if _, ok := v.Index(1).Int(); ok {
+		t.Error("Int() accepted a fraction")
+	}
+	if _, ok := v.Index(2).Int(); ok {
+		t.Error("Int() accepted a float64 that overflows int64")
+	}
+	if f, ok := v.Index(1).Float(); !ok || f != 2.5 {
+		t.Errorf("Float() = %g, %v, want 2.5", f, ok)
+	}
+	if got, _ := v.Array(); !reflect.DeepEqual(got, Array{3.0, 2.5, 1e19}) {
+		t.Errorf("Array() = %v", got)
+	}
+}
@@ -0,0 +1,60 @@
+package jason
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+)
+
+// SkipChildren is used as a return value from the function passed to Walk
+// to indicate that the children of the current value are to be skipped.
+var SkipChildren = errors.New("skip children")
+
+// Walk walks the decoded tree v depth first, calling fn for each value,
+// including v itself and any intermediate objects and arrays,
+// with its JSON Pointer path.
+//
+// Objects are walked in sorted key order, arrays in index order,
+// and values are visited before their children.
+// If fn returns SkipChildren, the children of the current value are skipped;
+// any other error stops the walk, and is returned by Walk.
+//
+// Example of collecting the paths of all strings:
+//
+//	jason.Walk(tree, func(path string, value any) error {
+//		if _, ok := value.(string); ok { paths = append(paths, path) }
+//		return nil
+//	})
+func Walk(v any, fn func(path string, value any) error) error {
+	return walk(nil, v, fn)
+}
+
+func walk(path []string, v any, fn func(path string, value any) error) error {
+	if err := fn(JoinPointer(path...), v); err != nil {
+		if err == SkipChildren {
+			return nil
+		}
+		return err
+	}
+
+	switch v := v.(type) {
+	case Object:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			if err := walk(append(path, k), v[k], fn); err != nil {
+				return err
+			}
+		}
+	case Array:
+		for i, e := range v {
+			if err := walk(append(path, strconv.Itoa(i)), e, fn); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package jason
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestWalk(t *testing.T) {
+	tree := Object{"b": Array{1, Object{"c": true}}, "a/": "x", "d": Object{"e": nil}}
+	stop := errors.New("stop")
+	tests := []struct {
+		name    string
+		at      string
+		ret     error
+		want    []string
+		wantErr error
+	}{
+		{name: "all", want: []string{"", "/a~1", "/b", "/b/0", "/b/1", "/b/1/c", "/d", "/d/e"}},
+		{name: "skip", at: "/b", ret: SkipChildren, want: []string{"", "/a~1", "/b", "/d", "/d/e"}},
+		{name: "stop", at: "/b/1", ret: stop, want: []string{"", "/a~1", "/b", "/b/0", "/b/1"}, wantErr: stop},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			err := Walk(tree, func(path string, value any) error {
+				got = append(got, path)
+				if path == tt.at {
+					return tt.ret
+				}
+				return nil
+			})
+			if err != tt.wantErr {
+				t.Errorf("Walk() error = %v, want %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Walk() visited %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
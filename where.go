@@ -0,0 +1,58 @@
+package jason
+
+import "math/big"
+
+// Where returns the elements of arr for which match reports true
+// given the value at the JSON Pointer ptr within each element.
+//
+// The order of elements is preserved.
+// Elements where ptr does not resolve to a value are excluded.
+//
+// Example of keeping only active users:
+//
+//	jason.Where(users, "/status", jason.EqString("active"))
+func Where(arr RawArray, ptr string, match func(RawValue) bool) (RawArray, error) {
+	if _, err := SplitPointer(ptr); err != nil {
+		return nil, err
+	}
+	var res RawArray
+	for _, v := range arr {
+		val, err := Pointer(v, ptr)
+		if err != nil {
+			if isMissing(err) {
+				continue
+			}
+			return nil, err
+		}
+		if match(val) {
+			res = append(res, v)
+		}
+	}
+	return res, nil
+}
+
+// EqString returns a matcher for JSON strings equal to s.
+func EqString(s string) func(RawValue) bool {
+	return func(j RawValue) bool {
+		if KindOf(j) != StringKind {
+			return false
+		}
+		v, err := AsA[string](j)
+		return err == nil && v == s
+	}
+}
+
+// NumGreater returns a matcher for JSON numbers greater than n.
+// Numbers are compared exactly; n must be finite.
+func NumGreater(n float64) func(RawValue) bool {
+	var x big.Rat
+	x.SetFloat64(n)
+	return func(j RawValue) bool {
+		y, ok := sortNumber(j)
+		if !ok {
+			return false
+		}
+		r, err := ToBigRat(y)
+		return err == nil && r.Cmp(&x) > 0
+	}
+}
@@ -0,0 +1,91 @@
+package jason
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestWhere(t *testing.T) {
+	users := RawArray{
+		RawValue(`{"name":"a","status":"active","age":30}`),
+		RawValue(`{"name":"b","status":"closed","age":17}`),
+		RawValue(`{"name":"c"}`),
+		RawValue(`{"name":"d","status":"active","age":"40"}`),
+		RawValue(`"e"`),
+	}
+	tests := []struct {
+		name  string
+		ptr   string
+		match func(RawValue) bool
+		want  []int
+	}{
+		{"string", "/status", EqString("active"), []int{0, 3}},
+		{"number", "/age", NumGreater(18), []int{0}},
+		{"none", "/status", EqString("x"), nil},
+		{"root", "", EqString("e"), []int{4}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Where(users, tt.ptr, tt.match)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var want RawArray
+			for _, i := range tt.want {
+				want = append(want, users[i])
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("Where() = %s, want %s", got, want)
+			}
+		})
+	}
+
+	if _, err := Where(users, "status", EqString("active")); err == nil {
+		t.Error("Where() accepted an invalid pointer")
+	}
+	if _, err := Where(RawArray{RawValue(`{"a":`)}, "/a", EqString("")); err == nil {
+		t.Error("Where() accepted invalid JSON")
+	}
+}
+
+func TestEqString(t *testing.T) {
+	tests := []struct {
+		in   string
+		want bool
+	}{
+		{`"a"`, true},
+		{` "a" `, true},
+		{`"A"`, false},
+		{`"a`, false},
+		{`null`, false},
+		{`["a"]`, false},
+	}
+	for _, tt := range tests {
+		if got := EqString("a")(RawValue(tt.in)); got != tt.want {
+			t.Errorf("EqString(a)(%s) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestNumGreater(t *testing.T) {
+	tests := []struct {
+		n    float64
+		in   string
+		want bool
+	}{
+		{1, `2`, true},
+		{1, `1`, false},
+		{1, `1.0000000000000000000001`, true},
+		{0.1, `0.1`, false},
+		{0.1, `0.1000000000000000055511151231257828`, true},
+		{-1, `-0.5`, true},
+		{1e300, `1e301`, true},
+		{0, `"1"`, false},
+		{0, `true`, false},
+	}
+	for _, tt := range tests {
+		if got := NumGreater(tt.n)(RawValue(tt.in)); got != tt.want {
+			t.Errorf("NumGreater(%g)(%s) = %v, want %v", tt.n, tt.in, got, tt.want)
+		}
+	}
+}
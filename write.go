@@ -0,0 +1,70 @@
+package jason
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// WriteOption configures Write and WriteIndent.
+type WriteOption uint
+
+const (
+	// Unescaped does not escape <, > and & in strings, like FromUnescaped.
+	Unescaped WriteOption = 1 << iota
+)
+
+// Write encodes v to w, followed by a newline,
+// without building an intermediate []byte.
+//
+// A RawValue is written as is, without being re-encoded,
+// but it is validated first, so invalid JSON is never written.
+//
+// Example of writing a large response:
+//
+//	w.Header().Set("Content-Type", "application/json")
+//	err := jason.Write(w, resp)
+func Write(w io.Writer, v any, opts ...WriteOption) error {
+	if j, ok := v.(RawValue); ok {
+		if err := Validate(j); err != nil {
+			return fmt.Errorf("jason: write: %w", err)
+		}
+		return writeRaw(w, j)
+	}
+	return newEncoder(w, opts).Encode(v)
+}
+
+// WriteIndent is like Write, but indents the output, as by Indent.
+//
+// A RawValue is indented, but not otherwise re-encoded.
+func WriteIndent(w io.Writer, v any, prefix, indent string, opts ...WriteOption) error {
+	if j, ok := v.(RawValue); ok {
+		var buf bytes.Buffer
+		if err := json.Indent(&buf, j, prefix, indent); err != nil {
+			return err
+		}
+		return writeRaw(w, buf.Bytes())
+	}
+	enc := newEncoder(w, opts)
+	enc.SetIndent(prefix, indent)
+	return enc.Encode(v)
+}
+
+func newEncoder(w io.Writer, opts []WriteOption) *json.Encoder {
+	var o WriteOption
+	for _, opt := range opts {
+		o |= opt
+	}
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(o&Unescaped == 0)
+	return enc
+}
+
+func writeRaw(w io.Writer, j RawValue) error {
+	if _, err := w.Write(j); err != nil {
+		return err
+	}
+	_, err := w.Write([]byte{'\n'})
+	return err
+}
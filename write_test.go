@@ -0,0 +1,92 @@
+package jason
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestWrite_raw(t *testing.T) {
+	tests := []struct {
+		in      RawValue
+		want    string
+		wantErr bool
+	}{
+		{in: RawValue(`{"a":1}`), want: "{\"a\":1}\n"},
+		{in: RawValue(` [1, 2] `), want: " [1, 2] \n"},
+		{in: RawValue(`{"a":`), wantErr: true},
+		{in: RawValue(`[1] [2]`), wantErr: true},
+		{in: RawValue(``), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.in), func(t *testing.T) {
+			var buf bytes.Buffer
+			err := Write(&buf, tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Write() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr && buf.Len() != 0 {
+				t.Errorf("Write() wrote %q on error", buf.String())
+			}
+			if got := buf.String(); !tt.wantErr && got != tt.want {
+				t.Errorf("Write() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWrite(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Write(&buf, Object{"a": "<&>"}); err != nil {
+		t.Fatal(err)
+	}
+	if got := buf.String(); got != "{\"a\":\"\\u003c\\u0026\\u003e\"}\n" {
+		t.Errorf("Write() = %q", got)
+	}
+
+	buf.Reset()
+	if err := Write(&buf, Object{"a": "<&>"}, Unescaped); err != nil {
+		t.Fatal(err)
+	}
+	if got := buf.String(); got != "{\"a\":\"<&>\"}\n" {
+		t.Errorf("Write(Unescaped) = %q", got)
+	}
+
+	if err := Write(&buf, func() {}); err == nil {
+		t.Error("Write() accepted a func")
+	}
+	if err := Write(errWriter{}, RawValue(`1`)); err == nil {
+		t.Error("Write() ignored a writer error")
+	}
+}
+
+func TestWriteIndent(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      any
+		opts    []WriteOption
+		want    string
+		wantErr bool
+	}{
+		{name: "raw", in: RawValue(`{"a":[1,2]}`), want: "{\n>\t\"a\": [\n>\t\t1,\n>\t\t2\n>\t]\n>}\n"},
+		{name: "raw spaces", in: RawValue(` [ 1 ] `), want: "[\n>\t1\n>] \n"},
+		{name: "value", in: Object{"a": Array{"<"}}, want: "{\n>\t\"a\": [\n>\t\t\"\\u003c\"\n>\t]\n>}\n"},
+		{name: "unescaped", in: Array{"<&>"}, opts: []WriteOption{Unescaped}, want: "[\n>\t\"<&>\"\n>]\n"},
+		{name: "invalid raw", in: RawValue(`{"a":`), wantErr: true},
+		{name: "invalid value", in: func() {}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := WriteIndent(&buf, tt.in, ">", "\t", tt.opts...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("WriteIndent() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr && buf.Len() != 0 {
+				t.Errorf("WriteIndent() wrote %q on error", buf.String())
+			}
+			if got := buf.String(); !tt.wantErr && got != tt.want {
+				t.Errorf("WriteIndent() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}